@@ -53,9 +53,30 @@ func (m *allOfMatcher) Description() string {
 	return strings.Join(wrappedDescs, ", and ")
 }
 
-func (m *allOfMatcher) Matches(c interface{}) (err error) {
+func (m *allOfMatcher) DescribeNegation() string {
+	// Special case: the empty set.
+	if len(m.wrappedMatchers) == 0 {
+		return "is nothing"
+	}
+
+	// At least one of the wrapped matchers must fail.
+	negDescs := make([]string, len(m.wrappedMatchers))
+	for i, wrappedMatcher := range m.wrappedMatchers {
+		negDescs[i] = describeNegation(wrappedMatcher)
+	}
+
+	return strings.Join(negDescs, ", or ")
+}
+
+func (m *allOfMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *allOfMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
 	for _, wrappedMatcher := range m.wrappedMatchers {
-		if wrappedErr := wrappedMatcher.Matches(c); wrappedErr != nil {
+		if wrappedErr := o.matches(wrappedMatcher, c); wrappedErr != nil {
 			err = wrappedErr
 
 			// If the error is fatal, return immediately with this error.
@@ -27,6 +27,16 @@ func (m *anyMatcher) Description() string {
 	return "is anything"
 }
 
+func (m *anyMatcher) DescribeNegation() string {
+	return "is nothing"
+}
+
 func (m *anyMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *anyMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
 	return nil
 }
@@ -16,7 +16,6 @@
 package oglematchers
 
 import (
-	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -71,12 +70,33 @@ func (m *anyOfMatcher) Description() string {
 	return fmt.Sprintf("or(%s)", strings.Join(wrappedDescs, ", "))
 }
 
-func (m *anyOfMatcher) Matches(c interface{}) (err error) {
-	err = errors.New("")
+func (m *anyOfMatcher) DescribeNegation() string {
+	// Special case: the empty set.
+	if len(m.wrapped) == 0 {
+		return "is anything"
+	}
+
+	// Each of the wrapped matchers must fail.
+	negDescs := make([]string, len(m.wrapped))
+	for i, matcher := range m.wrapped {
+		negDescs[i] = describeNegation(matcher)
+	}
+
+	return strings.Join(negDescs, ", and ")
+}
+
+func (m *anyOfMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *anyOfMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	err = errNoMatch
 
 	// Try each matcher in turn.
 	for _, matcher := range m.wrapped {
-		wrappedErr := matcher.Matches(c)
+		wrappedErr := o.matches(matcher, c)
 
 		// Return immediately if there's a match.
 		if wrappedErr == nil {
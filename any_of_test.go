@@ -17,6 +17,8 @@ package oglematchers_test
 
 import (
 	"errors"
+	"os"
+	"testing"
 
 	. "github.com/jacobsa/oglematchers"
 	. "github.com/jacobsa/ogletest"
@@ -135,5 +137,35 @@ func (t *AnyOfTest) DescriptionForNonEmptySet() {
 		&fakeAnyOfMatcher{"enchilada", nil},
 	)
 
-	ExpectEq("or(taco, burrito, enchilada)", matcher.Description())
+	ExpectEq("or(taco, \"burrito\", enchilada)", matcher.Description())
+}
+
+func (t *AnyOfTest) MatchingBuiltInsDoesntAllocate() {
+	// Observing matchers, as tracing does, necessarily allocates.
+	if os.Getenv("OGLEMATCHERS_TRACE") != "" {
+		return
+	}
+
+	matcher := AnyOf(17, "taco", LessThan(0))
+
+	for _, c := range []interface{}{17, "taco", -1, 19} {
+		allocs := testing.AllocsPerRun(100, func() { matcher.Matches(c) })
+		ExpectEq(0, allocs, "%v", c)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////
+// Benchmarks
+////////////////////////////////////////////////////////////////////////
+
+func BenchmarkAnyOf(b *testing.B) {
+	matcher := AnyOf(1, 2, 3, GreaterThan(100))
+	var c interface{} = 3
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		matcher.Matches(c)
+	}
 }
@@ -0,0 +1,341 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// At returns a matcher that locates the value at the supplied path within the
+// candidate and matches it against m. If m is not a Matcher, it is treated as
+// Equals(m).
+//
+// A path is a sequence of segments, each of which is one of the following:
+//
+//  *  A name, as in ".b" (or "b" at the start of the path), selecting an
+//     exported struct field or the value for a string map key.
+//
+//  *  A quoted string, as in `["some key"]` or `."some key"`, selecting the
+//     value for a map key that is not a valid name. The usual Go escapes are
+//     supported.
+//
+//  *  An integer index, as in "[2]", selecting an element of a slice, array,
+//     or string, or the value for an integer map key.
+//
+// Pointers and interfaces are dereferenced implicitly before each segment is
+// applied. For example:
+//
+//     config := map[string]interface{}{
+//       "servers": []*Server{{Name: "taco"}, {Name: "burrito"}},
+//     }
+//
+//     At("servers[1].Name", "burrito")  // matches config
+//
+// If the path cannot be resolved for a particular candidate, the matcher
+// returns a fatal error naming the first segment that failed. At panics if the
+// path is not syntactically valid.
+func At(path string, m interface{}) Matcher {
+	segments, err := parsePath(path)
+	if err != nil {
+		panic("At: " + err.Error())
+	}
+
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &atMatcher{path, segments, wrapped}
+}
+
+type pathSegment struct {
+	// The text of the segment as it appeared in the path, for use in error
+	// messages.
+	text string
+
+	// Either a name (or quoted key), or an index.
+	isIndex bool
+	name    string
+	index   int
+}
+
+// Parse the supplied path into its segments.
+func parsePath(path string) (segments []pathSegment, err error) {
+	if path == "" {
+		err = errors.New("empty path")
+		return
+	}
+
+	for pos := 0; pos < len(path); {
+		start := pos
+		var seg pathSegment
+
+		switch {
+		// Names, quoted or otherwise. The leading dot is optional at the start
+		// of the path.
+		case path[pos] == '.' || pos == 0 && path[pos] != '[':
+			if path[pos] == '.' {
+				pos++
+			}
+
+			if pos < len(path) && path[pos] == '"' {
+				var n int
+				if seg.name, n, err = parseQuoted(path[pos:]); err != nil {
+					err = fmt.Errorf("at offset %d: %v", pos, err)
+					return
+				}
+
+				pos += n
+				break
+			}
+
+			end := pos
+			for end < len(path) && isNameChar(path[end], end == pos) {
+				end++
+			}
+
+			if end == pos {
+				err = fmt.Errorf("at offset %d: expected name", pos)
+				return
+			}
+
+			seg.name = path[pos:end]
+			pos = end
+
+		// Indices and quoted keys in brackets.
+		case path[pos] == '[':
+			pos++
+			if pos < len(path) && path[pos] == '"' {
+				var n int
+				if seg.name, n, err = parseQuoted(path[pos:]); err != nil {
+					err = fmt.Errorf("at offset %d: %v", pos, err)
+					return
+				}
+
+				pos += n
+			} else {
+				end := pos
+				for end < len(path) && (path[end] == '-' || '0' <= path[end] && path[end] <= '9') {
+					end++
+				}
+
+				if seg.index, err = strconv.Atoi(path[pos:end]); err != nil {
+					err = fmt.Errorf("at offset %d: expected index or quoted key", pos)
+					return
+				}
+
+				seg.isIndex = true
+				pos = end
+			}
+
+			if pos >= len(path) || path[pos] != ']' {
+				err = fmt.Errorf("at offset %d: expected ']'", pos)
+				return
+			}
+
+			pos++
+
+		default:
+			err = fmt.Errorf("at offset %d: unexpected character %q", pos, path[pos])
+			return
+		}
+
+		seg.text = path[start:pos]
+		segments = append(segments, seg)
+	}
+
+	return
+}
+
+func isNameChar(c byte, first bool) bool {
+	switch {
+	case c == '_', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		return true
+
+	case '0' <= c && c <= '9':
+		return !first
+	}
+
+	return false
+}
+
+// Parse a Go-quoted string at the start of s, returning its value and length.
+func parseQuoted(s string) (value string, n int, err error) {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+
+		case '"':
+			value, err = strconv.Unquote(s[:i+1])
+			n = i + 1
+			return
+		}
+	}
+
+	err = errors.New("unterminated quoted string")
+	return
+}
+
+type atMatcher struct {
+	path     string
+	segments []pathSegment
+	wrapped  Matcher
+}
+
+func (m *atMatcher) Description() string {
+	return fmt.Sprintf("whose %s matches: %s", m.path, m.wrapped.Description())
+}
+
+func (m *atMatcher) DescribeNegation() string {
+	return fmt.Sprintf("whose %s doesn't match: %s", m.path, m.wrapped.Description())
+}
+
+// Follow pointers and interfaces until reaching a concrete value.
+func indirectValue(v reflect.Value) (reflect.Value, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, fmt.Errorf("nil %v", v.Type())
+		}
+
+		v = v.Elem()
+	}
+
+	return v, nil
+}
+
+// Apply a single segment to the supplied value.
+func applySegment(v reflect.Value, seg pathSegment) (reflect.Value, error) {
+	if !v.IsValid() {
+		return v, errors.New("nil value")
+	}
+
+	v, err := indirectValue(v)
+	if err != nil {
+		return v, err
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if seg.isIndex {
+			return v, fmt.Errorf("can't index %v", v.Type())
+		}
+
+		f, ok := v.Type().FieldByName(seg.name)
+		if !ok {
+			return v, fmt.Errorf("%v has no field %s", v.Type(), seg.name)
+		}
+
+		if f.PkgPath != "" {
+			return v, fmt.Errorf("field %s of %v is unexported", seg.name, v.Type())
+		}
+
+		// Promoted fields may be reached through nil embedded pointers.
+		fv, err := v.FieldByIndexErr(f.Index)
+		if err != nil {
+			return v, fmt.Errorf("field %s of %v is behind a nil embedded pointer", seg.name, v.Type())
+		}
+
+		return fv, nil
+
+	case reflect.Map:
+		var key reflect.Value
+		kt := v.Type().Key()
+
+		switch {
+		case !seg.isIndex && kt.Kind() == reflect.String:
+			key = reflect.ValueOf(seg.name).Convert(kt)
+
+		case seg.isIndex && isInteger(reflect.Zero(kt)):
+			key = reflect.ValueOf(seg.index).Convert(kt)
+
+		default:
+			return v, fmt.Errorf("wrong key type for %v", v.Type())
+		}
+
+		elem := v.MapIndex(key)
+		if !elem.IsValid() {
+			return v, fmt.Errorf("no such key in %v", v.Type())
+		}
+
+		return elem, nil
+
+	case reflect.Slice, reflect.Array, reflect.String:
+		if !seg.isIndex {
+			return v, fmt.Errorf("can't select %s from %v", seg.name, v.Type())
+		}
+
+		if seg.index < 0 || seg.index >= v.Len() {
+			return v, fmt.Errorf("index out of range for length %d", v.Len())
+		}
+
+		return v.Index(seg.index), nil
+	}
+
+	return v, fmt.Errorf("can't apply to %v", v.Type())
+}
+
+func (m *atMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *atMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	// Resolve the path.
+	v := reflect.ValueOf(c)
+	for i, seg := range m.segments {
+		var err error
+		if v, err = applySegment(v, seg); err != nil {
+			var resolved []string
+			for _, s := range m.segments[:i+1] {
+				resolved = append(resolved, s.text)
+			}
+
+			return NewFatalError(fmt.Sprintf(
+				"which has no %s (%v)",
+				strings.Join(resolved, ""),
+				err))
+		}
+	}
+
+	// Defer to the wrapped matcher.
+	var target interface{}
+	if v.IsValid() {
+		target = v.Interface()
+	}
+
+	err = o.matches(m.wrapped, target)
+	if err == nil {
+		return nil
+	}
+
+	wrappedClause := ""
+	if err.Error() != "" {
+		wrappedClause = ", " + err.Error()
+	}
+
+	s := fmt.Sprintf("whose %s is %s%s", m.path, FormatValue(target), wrappedClause)
+	if _, isFatal := err.(*FatalError); isFatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
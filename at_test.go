@@ -0,0 +1,190 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"errors"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type AtTest struct {
+}
+
+func init() { RegisterTestSuite(&AtTest{}) }
+
+type atServer struct {
+	Name  string
+	Ports []int
+	Tags  map[string]string
+	inner int
+}
+
+type atConfig struct {
+	Servers []*atServer
+	Extra   interface{}
+}
+
+func makeAtConfig() *atConfig {
+	return &atConfig{
+		Servers: []*atServer{
+			{Name: "taco", Ports: []int{80, 443}},
+			{Name: "burrito", Tags: map[string]string{"env": "prod", "a b": "c"}},
+			nil,
+		},
+		Extra: map[string]interface{}{
+			"levels": []interface{}{"debug", map[int]string{17: "info"}},
+		},
+	}
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *AtTest) Description() {
+	m := At("a.b[2].c", &fakeMatcher{nil, "taco"})
+	ExpectEq("whose a.b[2].c matches: taco", m.Description())
+
+	m = At("a", 17)
+	ExpectEq("whose a matches: 17", m.Description())
+}
+
+func (t *AtTest) InvalidPaths() {
+	ExpectThat(func() { At("", 0) }, Panics(HasSubstr("empty path")))
+	ExpectThat(func() { At("a..b", 0) }, Panics(HasSubstr("offset 2: expected name")))
+	ExpectThat(func() { At("a[", 0) }, Panics(HasSubstr("offset 2: expected index")))
+	ExpectThat(func() { At("a[x]", 0) }, Panics(HasSubstr("offset 2: expected index")))
+	ExpectThat(func() { At("a[1", 0) }, Panics(HasSubstr("offset 3: expected ']'")))
+	ExpectThat(func() { At(`a["b]`, 0) }, Panics(HasSubstr("unterminated")))
+	ExpectThat(func() { At("a b", 0) }, Panics(HasSubstr("offset 1: unexpected character")))
+	ExpectThat(func() { At("1a", 0) }, Panics(HasSubstr("offset 0: expected name")))
+}
+
+func (t *AtTest) StructFieldsAndIndices() {
+	c := makeAtConfig()
+
+	ExpectEq(nil, At("Servers[0].Name", "taco").Matches(c))
+	ExpectEq(nil, At(".Servers[1].Name", "burrito").Matches(c))
+	ExpectEq(nil, At("Servers[0].Ports[1]", 443).Matches(c))
+	ExpectEq(nil, At("Servers[0].Name[0]", 't').Matches(c))
+}
+
+func (t *AtTest) MapKeys() {
+	c := makeAtConfig()
+
+	ExpectEq(nil, At("Servers[1].Tags.env", "prod").Matches(c))
+	ExpectEq(nil, At(`Servers[1].Tags["env"]`, "prod").Matches(c))
+	ExpectEq(nil, At(`Servers[1].Tags["a b"]`, "c").Matches(c))
+	ExpectEq(nil, At(`Servers[1].Tags."a b"`, "c").Matches(c))
+	ExpectEq(nil, At("Extra.levels[1][17]", "info").Matches(c))
+}
+
+func (t *AtTest) InterfacesAndPointers() {
+	c := makeAtConfig()
+
+	ExpectEq(nil, At("Extra.levels[0]", "debug").Matches(c))
+	ExpectEq(nil, At("Servers[2]", IdenticalTo((*atServer)(nil))).Matches(c))
+	ExpectEq(nil, At("Servers[0]", Pointee(HasSameTypeAs(atServer{}))).Matches(&c))
+}
+
+func (t *AtTest) UnresolvablePaths() {
+	c := makeAtConfig()
+	var err error
+
+	// Missing field
+	err = At("Servers[0].Taco", "").Matches(c)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which has no Servers[0].Taco (")))
+	ExpectThat(err, Error(HasSubstr("has no field Taco")))
+
+	// Unexported field
+	err = At("Servers[0].inner", 0).Matches(c)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which has no Servers[0].inner (")))
+	ExpectThat(err, Error(HasSubstr("unexported")))
+
+	// Index out of range
+	err = At("Servers[3].Name", "").Matches(c)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which has no Servers[3] (")))
+	ExpectThat(err, Error(HasSubstr("out of range for length 3")))
+
+	// Nil pointer
+	err = At("Servers[2].Name", "").Matches(c)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which has no Servers[2].Name (")))
+	ExpectThat(err, Error(HasSubstr("nil")))
+
+	// Nil embedded pointer
+	err = At("Name", "").Matches(struct{ *atServer }{})
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which has no Name (")))
+	ExpectThat(err, Error(HasSubstr("nil embedded pointer")))
+
+	// Missing key
+	err = At("Servers[1].Tags.taco", "").Matches(c)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which has no Servers[1].Tags.taco (")))
+	ExpectThat(err, Error(HasSubstr("no such key")))
+
+	// Wrong key type
+	err = At("Servers[1].Tags[0]", "").Matches(c)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which has no Servers[1].Tags[0] (")))
+	ExpectThat(err, Error(HasSubstr("wrong key type")))
+
+	// Name applied to a slice
+	err = At("Servers.Name", "").Matches(c)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which has no Servers.Name (")))
+
+	// Nil candidate
+	err = At("Servers", "").Matches(nil)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which has no Servers (")))
+}
+
+func (t *AtTest) WrappedReturnsNonFatalEmptyError() {
+	err := At("Servers[0].Name", "burrito").Matches(makeAtConfig())
+
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose Servers[0].Name is \"taco\"")))
+}
+
+func (t *AtTest) WrappedReturnsNonFatalNonEmptyError() {
+	wrapped := &fakeMatcher{
+		func(c interface{}) error { return errors.New("which is spicy") },
+		"",
+	}
+
+	err := At("Servers[0].Name", wrapped).Matches(makeAtConfig())
+
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose Servers[0].Name is \"taco\", which is spicy")))
+}
+
+func (t *AtTest) WrappedReturnsFatalError() {
+	err := At("Servers[0].Name", LessThan(17)).Matches(makeAtConfig())
+
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("whose Servers[0].Name is \"taco\", which")))
+}
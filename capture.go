@@ -0,0 +1,315 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+// The matchers in this file record the values they match, typically so that
+// the argument of a mock call can be examined after the call. Destinations
+// are written and read under a lock shared by all of the matchers, so they may
+// be used from several goroutines at once. Code that reads a destination
+// directly should of course do so only once the goroutines calling the
+// matchers are done.
+
+// Protects all capture destinations, and the capture states below.
+var captureMutex sync.Mutex
+
+// The states of the destinations used with Capture and SameAsCaptured, so that
+// the latter can tell whether something has been captured. The map is keyed by
+// address rather than pointer so that it doesn't keep destinations alive, and
+// each state is released once the matchers using it are garbage collected.
+var captureStates = make(map[captureKey]*captureState)
+
+type captureKey struct {
+	addr uintptr
+	t    reflect.Type
+}
+
+type captureState struct {
+	// The number of live matchers using this state.
+	refs int
+
+	// Whether a value has been captured into the destination.
+	captured bool
+}
+
+// Return the state for the destination to which the non-nil pointer v points,
+// holding a reference to it on behalf of the matcher m until m is garbage
+// collected. Since m refers to the destination, the address in the key can't
+// be reused while the state is in the map.
+func acquireCaptureState(v reflect.Value, m interface{}) *captureState {
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+
+	k := captureKey{v.Pointer(), v.Type()}
+	s := captureStates[k]
+	if s == nil {
+		s = &captureState{}
+		captureStates[k] = s
+	}
+
+	s.refs++
+	runtime.SetFinalizer(m, func(interface{}) { releaseCaptureState(k) })
+
+	return s
+}
+
+func releaseCaptureState(k captureKey) {
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+
+	s := captureStates[k]
+	if s.refs--; s.refs == 0 {
+		delete(captureStates, k)
+	}
+}
+
+// Capture returns a matcher that matches the same values as m, additionally
+// assigning each value it matches to *dst. dst must be a non-nil pointer, and
+// candidates not assignable to its pointee type result in a fatal error. If m
+// is not a Matcher, it is treated as Equals(m). For example:
+//
+//     var req *http.Request
+//     ExpectCall(client, "Do")(Capture(&req, Any()))
+//
+func Capture(dst interface{}, m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		panic(fmt.Sprintf(
+			"Capture: destination must be a non-nil pointer, not %v",
+			reflect.TypeOf(dst)))
+	}
+
+	cm := &captureMatcher{elem: v.Elem(), wrapped: wrapped}
+	cm.state = acquireCaptureState(v, cm)
+
+	return cm
+}
+
+// CaptureAll is like Capture, except that dst must point to a slice, and each
+// matched value is appended to it.
+func CaptureAll(dst interface{}, m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Slice {
+		panic(fmt.Sprintf(
+			"CaptureAll: destination must be a non-nil pointer to a slice, not %v",
+			reflect.TypeOf(dst)))
+	}
+
+	return &captureAllMatcher{v.Elem(), wrapped}
+}
+
+// SameAsCaptured returns a matcher that matches values deeply equal, in the
+// sense of reflect.DeepEqual, to the value most recently captured into dst by
+// a matcher returned by Capture. Until something has been captured, it
+// returns fatal errors. dst must be a non-nil pointer. For example:
+//
+//     var id string
+//     ExpectCall(store, "Create")(Capture(&id, HasSubstr("order-")))
+//     ExpectCall(store, "Commit")(SameAsCaptured(&id))
+//
+func SameAsCaptured(dst interface{}) Matcher {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		panic(fmt.Sprintf(
+			"SameAsCaptured: destination must be a non-nil pointer, not %v",
+			reflect.TypeOf(dst)))
+	}
+
+	m := &sameAsCapturedMatcher{elem: v.Elem()}
+	m.state = acquireCaptureState(v, m)
+
+	return m
+}
+
+// Convert the candidate to a value assignable to the supplied type, or return
+// a fatal error.
+func captureValue(c interface{}, t reflect.Type) (reflect.Value, error) {
+	if c == nil {
+		switch t.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+			return reflect.Zero(t), nil
+		}
+	} else if v := reflect.ValueOf(c); v.Type().AssignableTo(t) {
+		return v, nil
+	}
+
+	return reflect.Value{}, NewFatalError(fmt.Sprintf(
+		"which has type %v, not assignable to %v",
+		reflect.TypeOf(c),
+		t))
+}
+
+////////////////////////////////////////////////////////////////////////
+// Capture
+////////////////////////////////////////////////////////////////////////
+
+type captureMatcher struct {
+	elem    reflect.Value
+	wrapped Matcher
+
+	// Protected by captureMutex.
+	state *captureState
+}
+
+func (m *captureMatcher) Description() string {
+	return m.wrapped.Description()
+}
+
+func (m *captureMatcher) DescribeNegation() string {
+	return describeNegation(m.wrapped)
+}
+
+func (m *captureMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *captureMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	v, err := captureValue(c, m.elem.Type())
+	if err != nil {
+		return err
+	}
+
+	if err = o.matches(m.wrapped, c); err != nil {
+		return err
+	}
+
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+
+	m.elem.Set(v)
+	m.state.captured = true
+
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////
+// CaptureAll
+////////////////////////////////////////////////////////////////////////
+
+type captureAllMatcher struct {
+	slice   reflect.Value
+	wrapped Matcher
+}
+
+func (m *captureAllMatcher) Description() string {
+	return m.wrapped.Description()
+}
+
+func (m *captureAllMatcher) DescribeNegation() string {
+	return describeNegation(m.wrapped)
+}
+
+func (m *captureAllMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *captureAllMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	v, err := captureValue(c, m.slice.Type().Elem())
+	if err != nil {
+		return err
+	}
+
+	if err = o.matches(m.wrapped, c); err != nil {
+		return err
+	}
+
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+
+	m.slice.Set(reflect.Append(m.slice, v))
+
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////
+// SameAsCaptured
+////////////////////////////////////////////////////////////////////////
+
+type sameAsCapturedMatcher struct {
+	elem reflect.Value
+
+	// Protected by captureMutex.
+	state *captureState
+}
+
+// Return the captured value, or false if nothing has been captured.
+func (m *sameAsCapturedMatcher) captured() (interface{}, bool) {
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+
+	if !m.state.captured {
+		return nil, false
+	}
+
+	return m.elem.Interface(), true
+}
+
+func (m *sameAsCapturedMatcher) Description() string {
+	x, ok := m.captured()
+	if !ok {
+		return "same as captured value (none yet)"
+	}
+
+	return fmt.Sprintf("same as captured value %s", FormatValue(x))
+}
+
+func (m *sameAsCapturedMatcher) DescribeNegation() string {
+	x, ok := m.captured()
+	if !ok {
+		return "not same as captured value (none yet)"
+	}
+
+	return fmt.Sprintf("not same as captured value %s", FormatValue(x))
+}
+
+func (m *sameAsCapturedMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *sameAsCapturedMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	x, ok := m.captured()
+	if !ok {
+		return NewFatalError("but nothing has been captured")
+	}
+
+	if reflect.DeepEqual(x, c) {
+		return nil
+	}
+
+	return errNoMatch
+}
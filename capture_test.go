@@ -0,0 +1,178 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type CaptureTest struct {
+}
+
+func init() { RegisterTestSuite(&CaptureTest{}) }
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *CaptureTest) InvalidDestinations() {
+	var n int
+	var s []int
+
+	ExpectThat(func() { Capture(n, Any()) }, Panics(HasSubstr("non-nil pointer, not int")))
+	ExpectThat(func() { Capture(nil, Any()) }, Panics(HasSubstr("non-nil pointer, not <nil>")))
+	ExpectThat(func() { CaptureAll(&n, Any()) }, Panics(HasSubstr("pointer to a slice, not *int")))
+	ExpectThat(func() { CaptureAll(s, Any()) }, Panics(HasSubstr("pointer to a slice, not []int")))
+	ExpectThat(func() { SameAsCaptured(n) }, Panics(HasSubstr("non-nil pointer, not int")))
+}
+
+func (t *CaptureTest) Descriptions() {
+	var n int
+	m := Capture(&n, GreaterThan(17))
+
+	ExpectEq("greater than 17", m.Description())
+	ExpectEq("is not greater than 17", Not(m).Description())
+	ExpectEq("greater than 17", CaptureAll(new([]int), GreaterThan(17)).Description())
+
+	same := SameAsCaptured(&n)
+	ExpectEq("same as captured value (none yet)", same.Description())
+	ExpectEq("not same as captured value (none yet)", Not(same).Description())
+
+	AssertEq(nil, m.Matches(19))
+	ExpectEq("same as captured value 19", same.Description())
+	ExpectEq("not same as captured value 19", Not(same).Description())
+}
+
+func (t *CaptureTest) CaptureOnlyWhenMatching() {
+	var n int
+	m := Capture(&n, GreaterThan(17))
+
+	err := m.Matches(17)
+	ExpectThat(err, Error(Equals("")))
+	ExpectEq(0, n)
+
+	ExpectEq(nil, m.Matches(19))
+	ExpectEq(19, n)
+
+	ExpectEq(nil, m.Matches(23))
+	ExpectEq(23, n)
+
+	// Non-matchers are treated as Equals.
+	ExpectEq(nil, Capture(&n, 29).Matches(29))
+	ExpectEq(29, n)
+}
+
+func (t *CaptureTest) WrongType() {
+	var n int
+	m := Capture(&n, Any())
+
+	err := m.Matches(int64(17))
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which has type int64, not assignable to int")))
+
+	err = m.Matches(nil)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which has type <nil>, not assignable to int")))
+
+	err = CaptureAll(new([]string), Any()).Matches(17)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which has type int, not assignable to string")))
+}
+
+func (t *CaptureTest) InterfaceDestination() {
+	var r io.Reader = strings.NewReader("")
+	m := Capture(&r, Any())
+
+	sr := strings.NewReader("taco")
+	ExpectEq(nil, m.Matches(sr))
+	ExpectEq(sr, r)
+
+	ExpectEq(nil, m.Matches(nil))
+	ExpectEq(nil, r)
+}
+
+func (t *CaptureTest) CaptureAll() {
+	var captured []string
+	m := CaptureAll(&captured, HasSubstr("a"))
+
+	ExpectEq(nil, m.Matches("taco"))
+	ExpectNe(nil, m.Matches("burrito"))
+	ExpectEq(nil, m.Matches("enchilada"))
+
+	ExpectThat(captured, ElementsAre("taco", "enchilada"))
+}
+
+func (t *CaptureTest) SameAsCaptured() {
+	var s []int
+	m := SameAsCaptured(&s)
+
+	err := m.Matches([]int{})
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("but nothing has been captured")))
+
+	// Captures into other destinations don't count.
+	var other []int
+	AssertEq(nil, Capture(&other, Any()).Matches([]int{1, 2}))
+	ExpectTrue(isFatal(m.Matches([]int{1, 2})))
+
+	AssertEq(nil, Capture(&s, Any()).Matches([]int{1, 2}))
+
+	ExpectEq(nil, m.Matches([]int{1, 2}))
+
+	err = m.Matches([]int{1, 3})
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("")))
+
+	ExpectNe(nil, m.Matches([]int64{1, 2}))
+}
+
+func (t *CaptureTest) ConcurrentUse() {
+	var last int
+	var all []int
+	m := AllOf(Capture(&last, Any()), CaptureAll(&all, Any()))
+	same := SameAsCaptured(&last)
+
+	const n = 100
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			m.Matches(i)
+			same.Description()
+		}(i)
+	}
+
+	wg.Wait()
+
+	AssertEq(n, len(all))
+	sort.Ints(all)
+	for i := 0; i < n; i++ {
+		ExpectEq(i, all[i])
+	}
+
+	ExpectEq(nil, same.Matches(last))
+}
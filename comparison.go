@@ -0,0 +1,124 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// A comparisonMatcher implements GreaterThan, GreaterOrEqual, and LessOrEqual
+// in terms of the logic of LessThan and Equals, calling them directly so that
+// matching doesn't allocate. The candidate is "below" the limit if LessThan
+// matches it, or if orEqual is set and Equals matches it. The matcher matches
+// candidates that are below the limit if matchBelow is set, and those that
+// aren't otherwise.
+//
+// This is equivalent to the definitions
+//
+//     GreaterThan(x)    = Not(LessOrEqual(x))
+//     GreaterOrEqual(x) = Not(LessThan(x))
+//     LessOrEqual(x)    = AnyOf(Equals(x), LessThan(x))
+//
+// including for NaN, complex candidates, and which error is returned.
+type comparisonMatcher struct {
+	name       string
+	phrase     string
+	lessThan   *lessThanMatcher
+	equals     *equalsMatcher
+	orEqual    bool
+	matchBelow bool
+}
+
+func newComparisonMatcher(
+	name string,
+	phrase string,
+	x interface{},
+	orEqual bool,
+	matchBelow bool) Matcher {
+	v := comparisonLimit(name, x)
+	return &comparisonMatcher{
+		name:       name,
+		phrase:     phrase,
+		lessThan:   &lessThanMatcher{v},
+		equals:     &equalsMatcher{v},
+		orEqual:    orEqual,
+		matchBelow: matchBelow,
+	}
+}
+
+func (m *comparisonMatcher) spec() (string, []interface{}) {
+	return m.name, []interface{}{m.lessThan.limit.Interface()}
+}
+
+func (m *comparisonMatcher) Description() string {
+	return fmt.Sprintf("%s %s", m.phrase, FormatValue(m.lessThan.limit.Interface()))
+}
+
+func (m *comparisonMatcher) DescribeNegation() string {
+	return fmt.Sprintf("is not %s %s", m.phrase, FormatValue(m.lessThan.limit.Interface()))
+}
+
+func (m *comparisonMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *comparisonMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	below := false
+
+	var equalsErr error
+	if m.orEqual {
+		equalsErr = m.equals.matches(c)
+		below = equalsErr == nil
+	}
+
+	if !below {
+		lessErr := m.lessThan.matches(c)
+		if lessErr == nil {
+			below = true
+		} else if _, isFatal := lessErr.(*FatalError); isFatal {
+			return lessErr
+		} else if _, isFatal := equalsErr.(*FatalError); isFatal {
+			return equalsErr
+		}
+	}
+
+	if below != m.matchBelow {
+		return errNoMatch
+	}
+
+	return nil
+}
+
+// Return the value of a limit for LessThan and friends, panicking if it isn't
+// an integer, floating point, or string value.
+func comparisonLimit(name string, x interface{}) reflect.Value {
+	v := reflect.ValueOf(x)
+	kind := v.Kind()
+
+	switch {
+	case isInteger(v):
+	case isFloat(v):
+	case kind == reflect.String:
+
+	default:
+		panic(fmt.Sprintf("%s: unexpected kind %v", name, kind))
+	}
+
+	return v
+}
@@ -17,12 +17,11 @@ package oglematchers
 
 import (
 	"fmt"
-	"reflect"
 )
 
-// Return a matcher that matches arrays slices with at least one element that
-// matches the supplied argument. If the argument x is not itself a Matcher,
-// this is equivalent to Contains(Equals(x)).
+// Return a matcher that matches arrays, slices, and other collections with at
+// least one element that matches the supplied argument. If the argument x is
+// not itself a Matcher, this is equivalent to Contains(Equals(x)).
 func Contains(x interface{}) Matcher {
 	var result containsMatcher
 	var ok bool
@@ -42,20 +41,33 @@ func (m *containsMatcher) Description() string {
 	return fmt.Sprintf("contains: %s", m.elementMatcher.Description())
 }
 
+func (m *containsMatcher) DescribeNegation() string {
+	return fmt.Sprintf("doesn't contain: %s", m.elementMatcher.Description())
+}
+
 func (m *containsMatcher) Matches(candidate interface{}) error {
-	// The candidate must be a slice or an array.
-	v := reflect.ValueOf(candidate)
-	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
-		return NewFatalError("which is not a slice or array")
+	return observeMatch(nil, m, candidate)
+}
+
+func (m *containsMatcher) observedMatches(
+	o *observation,
+	candidate interface{}) (err error) {
+	// The candidate must be a collection.
+	src, ok := newElementSource(candidate, false)
+	if !ok {
+		return notACollection(false)
 	}
 
-	// Check each element.
-	for i := 0; i < v.Len(); i++ {
-		elem := v.Index(i)
-		if matchErr := m.elementMatcher.Matches(elem.Interface()); matchErr == nil {
-			return nil
-		}
+	// Check each element, stopping at the first match.
+	found := false
+	n := src.visit(func(pos elementPos, elem interface{}) bool {
+		found = o.matches(m.elementMatcher, elem) == nil
+		return !found
+	})
+
+	if found {
+		return nil
 	}
 
-	return fmt.Errorf("")
+	return src.noteConsumed(errNoMatch, n)
 }
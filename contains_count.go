@@ -0,0 +1,134 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ContainsCount returns a matcher that matches arrays, slices, and other
+// collections for which the number of elements matching x is itself matched
+// by count. If x is not a Matcher, it is treated as Equals(x); the same goes
+// for count. The count is supplied to count as an int.
+//
+// For example:
+//
+//     ContainsCount("retry", 2)                   // exactly two "retry" elements
+//     ContainsCount(HasSubstr("a"), LessThan(3))  // at most two elements with "a"
+//
+// See also ContainsAtLeast, ContainsExactly, and ContainsAtMost.
+func ContainsCount(x interface{}, count interface{}) Matcher {
+	var result containsCountMatcher
+	var ok bool
+
+	if result.elementMatcher, ok = x.(Matcher); !ok {
+		result.elementMatcher = Equals(x)
+	}
+
+	if result.countMatcher, ok = count.(Matcher); !ok {
+		result.countMatcher = Equals(count)
+	}
+
+	return &result
+}
+
+// ContainsAtLeast(x, n) is equivalent to ContainsCount(x, GreaterOrEqual(n)).
+func ContainsAtLeast(x interface{}, n int) Matcher {
+	return ContainsCount(x, GreaterOrEqual(n))
+}
+
+// ContainsExactly(x, n) is equivalent to ContainsCount(x, Equals(n)).
+func ContainsExactly(x interface{}, n int) Matcher {
+	return ContainsCount(x, Equals(n))
+}
+
+// ContainsAtMost(x, n) is equivalent to ContainsCount(x, LessOrEqual(n)).
+func ContainsAtMost(x interface{}, n int) Matcher {
+	return ContainsCount(x, LessOrEqual(n))
+}
+
+type containsCountMatcher struct {
+	elementMatcher Matcher
+	countMatcher   Matcher
+}
+
+func (m *containsCountMatcher) Description() string {
+	return fmt.Sprintf(
+		"contains: %s, with count: %s",
+		m.elementMatcher.Description(),
+		m.countMatcher.Description())
+}
+
+func (m *containsCountMatcher) DescribeNegation() string {
+	return fmt.Sprintf(
+		"contains: %s, with count: %s",
+		m.elementMatcher.Description(),
+		describeNegation(m.countMatcher))
+}
+
+func (m *containsCountMatcher) Matches(candidate interface{}) error {
+	return observeMatch(nil, m, candidate)
+}
+
+func (m *containsCountMatcher) observedMatches(
+	o *observation,
+	candidate interface{}) (err error) {
+	// The candidate must be a collection.
+	src, ok := newElementSource(candidate, false)
+	if !ok {
+		return notACollection(false)
+	}
+
+	// Find the indices of the matching elements.
+	var indices []string
+	n := src.visit(func(pos elementPos, elem interface{}) bool {
+		if matchErr := o.matches(m.elementMatcher, elem); matchErr == nil {
+			indices = append(indices, fmt.Sprintf("%d", pos.index))
+		}
+
+		return true
+	})
+
+	countErr := o.matches(m.countMatcher, len(indices))
+	if countErr == nil {
+		return nil
+	}
+
+	// Describe the count and where the matches were. If the count matcher's
+	// error was fatal, make this one fatal too.
+	var s string
+	switch len(indices) {
+	case 0:
+		s = "which has 0 matching elements"
+
+	case 1:
+		s = fmt.Sprintf("which has 1 matching element, at index %s", indices[0])
+
+	default:
+		s = fmt.Sprintf(
+			"which has %d matching elements, at indices %s",
+			len(indices),
+			strings.Join(indices, ", "))
+	}
+
+	if _, isFatal := countErr.(*FatalError); isFatal {
+		return src.noteConsumed(NewFatalError(s), n)
+	}
+
+	return src.noteConsumed(errors.New(s), n)
+}
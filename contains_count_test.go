@@ -0,0 +1,142 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type ContainsCountTest struct {
+}
+
+func init() { RegisterTestSuite(&ContainsCountTest{}) }
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *ContainsCountTest) Descriptions() {
+	ExpectEq("contains: \"taco\", with count: 2", ContainsCount("taco", 2).Description())
+	ExpectEq(
+		"contains: has substring \"a\", with count: less than 3",
+		ContainsCount(HasSubstr("a"), LessThan(3)).Description())
+
+	ExpectEq(
+		"contains: \"taco\", with count: greater than or equal to 2",
+		ContainsAtLeast("taco", 2).Description())
+
+	ExpectEq(
+		"contains: \"taco\", with count: 2",
+		ContainsExactly("taco", 2).Description())
+
+	ExpectEq(
+		"contains: \"taco\", with count: less than or equal to 2",
+		ContainsAtMost("taco", 2).Description())
+}
+
+func (t *ContainsCountTest) WrongTypeCandidates() {
+	m := ContainsCount("", 1)
+
+	var err error
+
+	// Nil candidate
+	err = m.Matches(nil)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is not a slice, array, channel, iterator, or list")))
+
+	// String candidate
+	err = m.Matches("")
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is not a slice, array, channel, iterator, or list")))
+}
+
+func (t *ContainsCountTest) CountMatches() {
+	m := ContainsCount("retry", 2)
+
+	var c interface{}
+	var err error
+
+	// Slice
+	c = []string{"start", "retry", "retry", "done"}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+
+	// Array
+	c = [...]string{"retry", "start", "retry"}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+}
+
+func (t *ContainsCountTest) CountDoesntMatch() {
+	m := ContainsCount("retry", 2)
+
+	var c interface{}
+	var err error
+
+	// None
+	c = []string{"start", "done"}
+	err = m.Matches(c)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has 0 matching elements")))
+
+	// One
+	c = []string{"start", "retry", "done"}
+	err = m.Matches(c)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has 1 matching element, at index 1")))
+
+	// Three
+	c = []string{"retry", "start", "retry", "retry"}
+	err = m.Matches(c)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has 3 matching elements, at indices 0, 2, 3")))
+}
+
+func (t *ContainsCountTest) ElementMatcherFatalErrors() {
+	m := ContainsCount(HasSubstr("a"), 1)
+
+	err := m.Matches([]interface{}{17, "taco", 19})
+	ExpectEq(nil, err)
+}
+
+func (t *ContainsCountTest) CountMatcherFatalError() {
+	m := ContainsCount("taco", HasSubstr("1"))
+
+	err := m.Matches([]string{"taco"})
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which has 1 matching element, at index 0")))
+}
+
+func (t *ContainsCountTest) Convenience() {
+	c := []int{17, 19, 17}
+
+	ExpectEq(nil, ContainsAtLeast(17, 1).Matches(c))
+	ExpectEq(nil, ContainsAtLeast(17, 2).Matches(c))
+	ExpectNe(nil, ContainsAtLeast(17, 3).Matches(c))
+
+	ExpectNe(nil, ContainsExactly(17, 1).Matches(c))
+	ExpectEq(nil, ContainsExactly(17, 2).Matches(c))
+	ExpectNe(nil, ContainsExactly(17, 3).Matches(c))
+
+	ExpectNe(nil, ContainsAtMost(17, 1).Matches(c))
+	ExpectEq(nil, ContainsAtMost(17, 2).Matches(c))
+	ExpectEq(nil, ContainsAtMost(17, 3).Matches(c))
+}
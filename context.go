@@ -0,0 +1,315 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// The matchers in this file accept candidates implementing context.Context,
+// and return fatal errors for all others.
+
+// ContextDone returns a matcher that matches contexts whose Done channel is
+// closed, i.e. that have been cancelled or whose deadline has passed.
+func ContextDone() Matcher {
+	return &contextDoneMatcher{}
+}
+
+// ContextHasDeadline returns a matcher that matches contexts with a deadline,
+// where the time remaining until the deadline, as a time.Duration, matches m.
+// If m is not a Matcher, it is treated as Equals(m). For example:
+//
+//     ContextHasDeadline(AllOf(GreaterThan(0), LessOrEqual(5*time.Second)))
+//
+func ContextHasDeadline(m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &contextDeadlineMatcher{wrapped}
+}
+
+// ContextValue returns a matcher that matches contexts in which the value
+// associated with key matches m. The value is nil if there is none. If m is
+// not a Matcher, it is treated as Equals(m).
+func ContextValue(key interface{}, m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &contextValueMatcher{key, wrapped}
+}
+
+// ContextErr returns a matcher that matches contexts whose Err method returns
+// an error matching m. If m is an error rather than a Matcher, errors are
+// compared with errors.Is, so that e.g. ContextErr(context.Canceled) works
+// as expected. Otherwise if m is not a Matcher, it is treated as Equals(m);
+// in particular ContextErr(nil) matches contexts that are not yet done.
+func ContextErr(m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		if target, isError := m.(error); isError {
+			wrapped = &errorIsMatcher{target}
+		} else {
+			wrapped = Equals(m)
+		}
+	}
+
+	return &contextErrMatcher{wrapped}
+}
+
+func candidateContext(c interface{}) (context.Context, error) {
+	ctx, ok := c.(context.Context)
+	if !ok {
+		return nil, NewFatalError("which is not a context.Context")
+	}
+
+	return ctx, nil
+}
+
+////////////////////////////////////////////////////////////////////////
+// ContextDone
+////////////////////////////////////////////////////////////////////////
+
+type contextDoneMatcher struct {
+}
+
+func (m *contextDoneMatcher) Description() string {
+	return "context is done"
+}
+
+func (m *contextDoneMatcher) DescribeNegation() string {
+	return "context is not done"
+}
+
+func (m *contextDoneMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *contextDoneMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	ctx, err := candidateContext(c)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil
+
+	default:
+		return errors.New("which is not done")
+	}
+}
+
+////////////////////////////////////////////////////////////////////////
+// ContextHasDeadline
+////////////////////////////////////////////////////////////////////////
+
+type contextDeadlineMatcher struct {
+	wrapped Matcher
+}
+
+func (m *contextDeadlineMatcher) Description() string {
+	return fmt.Sprintf("context has deadline, with time remaining: %s", m.wrapped.Description())
+}
+
+func (m *contextDeadlineMatcher) DescribeNegation() string {
+	return fmt.Sprintf(
+		"context doesn't have deadline with time remaining: %s",
+		m.wrapped.Description())
+}
+
+func (m *contextDeadlineMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *contextDeadlineMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	ctx, err := candidateContext(c)
+	if err != nil {
+		return err
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return errors.New("which has no deadline")
+	}
+
+	remaining := time.Until(deadline)
+	if err = o.matches(m.wrapped, remaining); err == nil {
+		return nil
+	}
+
+	wrappedClause := ""
+	if err.Error() != "" {
+		wrappedClause = ", " + err.Error()
+	}
+
+	s := fmt.Sprintf("whose deadline is in %v%s", remaining, wrappedClause)
+	if _, isFatal := err.(*FatalError); isFatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
+
+////////////////////////////////////////////////////////////////////////
+// ContextValue
+////////////////////////////////////////////////////////////////////////
+
+type contextValueMatcher struct {
+	key     interface{}
+	wrapped Matcher
+}
+
+func (m *contextValueMatcher) Description() string {
+	return fmt.Sprintf(
+		"context value for key %s matches: %s",
+		FormatValue(m.key),
+		m.wrapped.Description())
+}
+
+func (m *contextValueMatcher) DescribeNegation() string {
+	return fmt.Sprintf(
+		"context value for key %s doesn't match: %s",
+		FormatValue(m.key),
+		m.wrapped.Description())
+}
+
+func (m *contextValueMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *contextValueMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	ctx, err := candidateContext(c)
+	if err != nil {
+		return err
+	}
+
+	value := ctx.Value(m.key)
+	if err = o.matches(m.wrapped, value); err == nil {
+		return nil
+	}
+
+	wrappedClause := ""
+	if err.Error() != "" {
+		wrappedClause = ", " + err.Error()
+	}
+
+	s := fmt.Sprintf(
+		"whose value for key %s is %s%s",
+		FormatValue(m.key),
+		FormatValue(value),
+		wrappedClause)
+
+	if _, isFatal := err.(*FatalError); isFatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
+
+////////////////////////////////////////////////////////////////////////
+// ContextErr
+////////////////////////////////////////////////////////////////////////
+
+type contextErrMatcher struct {
+	wrapped Matcher
+}
+
+func (m *contextErrMatcher) Description() string {
+	return fmt.Sprintf("context error matches: %s", m.wrapped.Description())
+}
+
+func (m *contextErrMatcher) DescribeNegation() string {
+	return fmt.Sprintf("context error doesn't match: %s", m.wrapped.Description())
+}
+
+func (m *contextErrMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *contextErrMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	ctx, err := candidateContext(c)
+	if err != nil {
+		return err
+	}
+
+	ctxErr := ctx.Err()
+	if err = o.matches(m.wrapped, ctxErr); err == nil {
+		return nil
+	}
+
+	wrappedClause := ""
+	if err.Error() != "" {
+		wrappedClause = ", " + err.Error()
+	}
+
+	s := fmt.Sprintf("whose error is %s%s", FormatValue(ctxErr), wrappedClause)
+	if _, isFatal := err.(*FatalError); isFatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
+
+// A matcher for errors that match a target according to errors.Is.
+type errorIsMatcher struct {
+	target error
+}
+
+func (m *errorIsMatcher) Description() string {
+	return fmt.Sprintf("is or wraps error: %s", FormatValue(m.target))
+}
+
+func (m *errorIsMatcher) DescribeNegation() string {
+	return fmt.Sprintf("is not and doesn't wrap error: %s", FormatValue(m.target))
+}
+
+func (m *errorIsMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *errorIsMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	if c == nil {
+		return errNoMatch
+	}
+
+	err, ok := c.(error)
+	if !ok {
+		return NewFatalError("which is not an error")
+	}
+
+	if !errors.Is(err, m.target) {
+		return errNoMatch
+	}
+
+	return nil
+}
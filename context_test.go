@@ -0,0 +1,158 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type ContextTest struct {
+}
+
+func init() { RegisterTestSuite(&ContextTest{}) }
+
+type contextTestKey string
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *ContextTest) Descriptions() {
+	ExpectEq("context is done", ContextDone().Description())
+	ExpectEq("context is not done", Not(ContextDone()).Description())
+
+	ExpectEq(
+		"context has deadline, with time remaining: less than 1s",
+		ContextHasDeadline(LessThan(time.Second)).Description())
+
+	ExpectEq(
+		"context value for key \"user\" matches: \"taco\"",
+		ContextValue(contextTestKey("user"), "taco").Description())
+
+	ExpectEq(
+		"context error matches: is or wraps error: context canceled",
+		ContextErr(context.Canceled).Description())
+	ExpectEq("context error matches: is nil", ContextErr(nil).Description())
+}
+
+func (t *ContextTest) WrongTypeCandidates() {
+	matchers := []Matcher{
+		ContextDone(),
+		ContextHasDeadline(Any()),
+		ContextValue("", Any()),
+		ContextErr(Any()),
+	}
+
+	for _, m := range matchers {
+		for _, c := range []interface{}{nil, 17, "taco"} {
+			err := m.Matches(c)
+			ExpectTrue(isFatal(err))
+			ExpectThat(err, Error(Equals("which is not a context.Context")))
+		}
+	}
+}
+
+func (t *ContextTest) Done() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := ContextDone().Matches(ctx)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which is not done")))
+
+	cancel()
+	ExpectEq(nil, ContextDone().Matches(ctx))
+}
+
+func (t *ContextTest) Deadline() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+
+	ExpectEq(nil, ContextHasDeadline(AllOf(GreaterThan(59*time.Minute), LessOrEqual(time.Hour))).Matches(ctx))
+	ExpectEq(nil, ContextHasDeadline(Any()).Matches(ctx))
+
+	err := ContextHasDeadline(LessThan(time.Minute)).Matches(ctx)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("whose deadline is in 59m59.")))
+
+	err = ContextHasDeadline(Any()).Matches(context.Background())
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has no deadline")))
+
+	err = ContextHasDeadline(HasSubstr("")).Matches(ctx)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr(", which is not a string")))
+}
+
+func (t *ContextTest) Value() {
+	ctx := context.WithValue(context.Background(), contextTestKey("user"), "taco")
+
+	ExpectEq(nil, ContextValue(contextTestKey("user"), "taco").Matches(ctx))
+	ExpectEq(nil, ContextValue(contextTestKey("user"), HasSubstr("ac")).Matches(ctx))
+	ExpectEq(nil, ContextValue(contextTestKey("missing"), nil).Matches(ctx))
+
+	// Keys are compared with their types.
+	ExpectEq(nil, ContextValue("user", nil).Matches(ctx))
+
+	err := ContextValue(contextTestKey("user"), "burrito").Matches(ctx)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose value for key \"user\" is \"taco\"")))
+
+	err = ContextValue(contextTestKey("user"), LessThan(17)).Matches(ctx)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("whose value for key \"user\" is \"taco\", ")))
+}
+
+func (t *ContextTest) Err() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ExpectEq(nil, ContextErr(nil).Matches(ctx))
+
+	err := ContextErr(context.Canceled).Matches(ctx)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose error is <nil>")))
+
+	cancel()
+	ExpectEq(nil, ContextErr(context.Canceled).Matches(ctx))
+	ExpectEq(nil, ContextErr(Error(HasSubstr("cancel"))).Matches(ctx))
+
+	err = ContextErr(context.DeadlineExceeded).Matches(ctx)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose error is context canceled")))
+
+	// Wrapped errors, as with WithCancelCause.
+	wrapped := fmt.Errorf("shutting down: %w", context.DeadlineExceeded)
+	ExpectEq(nil, ContextErr(wrapped).Matches(&errContext{context.Background(), wrapped}))
+	ExpectEq(nil, ContextErr(context.DeadlineExceeded).Matches(&errContext{context.Background(), wrapped}))
+}
+
+// A context that returns a fixed error.
+type errContext struct {
+	context.Context
+	err error
+}
+
+func (c *errContext) Err() error {
+	return c.err
+}
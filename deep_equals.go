@@ -24,9 +24,23 @@ import (
 
 var byteSliceType reflect.Type = reflect.TypeOf([]byte{})
 
+// The options used by DeepEquals, which match the behavior of
+// reflect.DeepEqual apart from Equal methods.
+var deepEqualsDefaults = deepEqualsOptions{compareUnexported: true}
+
 // DeepEquals returns a matcher that matches based on 'deep equality', as
 // defined by the reflect package. This matcher requires that values have
 // identical types to x.
+//
+// Unlike reflect.DeepEqual, values at any level of nesting whose types have a
+// method of the form Equal(U) bool, where the type is assignable to U, are
+// compared using that method rather than structurally. This gives the
+// expected results for types like time.Time, for which structural comparison
+// is wrong. Values held in unexported fields are always compared
+// structurally. For purely structural comparison, use strict mode:
+//
+//     DeepEqualsWith(x, CompareUnexported(), StrictEquality())
+//
 func DeepEquals(x interface{}) Matcher {
 	return &deepEqualsMatcher{x}
 }
@@ -36,20 +50,20 @@ type deepEqualsMatcher struct {
 }
 
 func (m *deepEqualsMatcher) Description() string {
-	xDesc := fmt.Sprintf("%v", m.x)
-	xValue := reflect.ValueOf(m.x)
-
-	// Special case: fmt.Sprintf presents nil slices as "[]", but
-	// reflect.DeepEqual makes a distinction between nil and empty slices. Make
-	// this less confusing.
-	if xValue.Kind() == reflect.Slice && xValue.IsNil() {
-		xDesc = "<nil slice>"
-	}
+	return fmt.Sprintf("deep equals: %s", FormatValue(m.x))
+}
 
-	return fmt.Sprintf("deep equals: %s", xDesc)
+func (m *deepEqualsMatcher) DescribeNegation() string {
+	return fmt.Sprintf("doesn't deep equal: %s", FormatValue(m.x))
 }
 
 func (m *deepEqualsMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *deepEqualsMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
 	// Make sure the types match.
 	ct := reflect.TypeOf(c)
 	xt := reflect.TypeOf(m.x)
@@ -70,11 +84,12 @@ func (m *deepEqualsMatcher) Matches(c interface{}) error {
 			return nil
 		}
 
-		return errors.New("")
+		return errNoMatch
 	}
 
-	// Defer to the reflect package.
-	if reflect.DeepEqual(m.x, c) {
+	// Compare structurally, honoring Equal methods.
+	cmp := deepComparison{opts: &deepEqualsDefaults}
+	if cmp.equal(cValue, xValue, "") {
 		return nil
 	}
 
@@ -84,5 +99,5 @@ func (m *deepEqualsMatcher) Matches(c interface{}) error {
 		return errors.New("which is nil")
 	}
 
-	return errors.New("")
+	return errNoMatch
 }
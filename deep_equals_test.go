@@ -19,7 +19,10 @@ import (
 	. "github.com/jacobsa/oglematchers"
 	. "github.com/jacobsa/ogletest"
 	"bytes"
+	"fmt"
+	"math"
 	"testing"
+	"time"
 )
 
 ////////////////////////////////////////////////////////////////////////
@@ -29,6 +32,23 @@ import (
 type DeepEqualsTest struct {}
 func init() { RegisterTestSuite(&DeepEqualsTest{}) }
 
+// A type whose Equal method ignores its currency's case, with a pointer
+// receiver.
+type deepEqualsMoney struct {
+	Cents    int
+	Currency string
+}
+
+func (m *deepEqualsMoney) Equal(o *deepEqualsMoney) bool {
+	return m.Cents == o.Cents && bytes.EqualFold([]byte(m.Currency), []byte(o.Currency))
+}
+
+type deepEqualsEvent struct {
+	When  time.Time
+	Price *deepEqualsMoney
+	when  time.Time
+}
+
 ////////////////////////////////////////////////////////////////////////
 // Tests
 ////////////////////////////////////////////////////////////////////////
@@ -314,6 +334,77 @@ func (t *DeepEqualsTest) NilOtherSliceValue() {
 	ExpectThat(err, Error(Equals("")))
 }
 
+func (t *DeepEqualsTest) EqualMethodAtTopLevel() {
+	x := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	m := DeepEquals(x)
+
+	ExpectEq(nil, m.Matches(x.In(time.FixedZone("x", 3600))))
+	ExpectThat(m.Matches(x.Add(1)), Error(Equals("")))
+}
+
+func (t *DeepEqualsTest) EqualMethodWhenNested() {
+	when := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	x := []deepEqualsEvent{
+		{When: when, Price: &deepEqualsMoney{150, "usd"}},
+	}
+
+	m := DeepEquals(x)
+
+	c := []deepEqualsEvent{
+		{When: when.In(time.FixedZone("x", 3600)), Price: &deepEqualsMoney{150, "USD"}},
+	}
+
+	ExpectEq(nil, m.Matches(c))
+
+	// Differences are still found.
+	c[0].Price.Cents = 151
+	ExpectThat(m.Matches(c), Error(Equals("")))
+
+	// Nil pointers are handled without calling Equal.
+	c[0].Price = nil
+	ExpectThat(m.Matches(c), Error(Equals("")))
+
+	x[0].Price = nil
+	ExpectEq(nil, m.Matches(c))
+}
+
+func (t *DeepEqualsTest) UnexportedFieldsAreComparedStructurally() {
+	when := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	m := DeepEquals(deepEqualsEvent{when: when})
+
+	ExpectEq(nil, m.Matches(deepEqualsEvent{when: when}))
+	ExpectThat(
+		m.Matches(deepEqualsEvent{when: when.In(time.FixedZone("x", 3600))}),
+		Error(Equals("")))
+}
+
+func (t *DeepEqualsTest) Infinities() {
+	ExpectEq(nil, DeepEquals(math.Inf(1)).Matches(math.Inf(1)))
+	ExpectEq(nil, DeepEquals(float32(math.Inf(-1))).Matches(float32(math.Inf(-1))))
+	ExpectThat(DeepEquals(math.Inf(1)).Matches(math.Inf(-1)), Error(Equals("")))
+	ExpectThat(DeepEquals(math.Inf(1)).Matches(math.MaxFloat64), Error(Equals("")))
+
+	type bounds struct {
+		Lo, Hi float64
+	}
+
+	x := []bounds{{math.Inf(-1), math.Inf(1)}}
+	ExpectEq(nil, DeepEquals(x).Matches([]bounds{{math.Inf(-1), math.Inf(1)}}))
+	ExpectThat(DeepEquals(x).Matches([]bounds{{math.Inf(-1), 17}}), Error(Equals("")))
+}
+
+func (t *DeepEqualsTest) StrictMode() {
+	when := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	x := deepEqualsEvent{When: when, Price: &deepEqualsMoney{150, "usd"}}
+	c := deepEqualsEvent{When: when, Price: &deepEqualsMoney{150, "USD"}}
+
+	ExpectEq(nil, DeepEquals(x).Matches(c))
+
+	m := DeepEqualsWith(x, CompareUnexported(), StrictEquality())
+	ExpectThat(m.Description(), HasSubstr(", comparing unexported fields, ignoring Equal methods"))
+	ExpectThat(m.Matches(c), Error(Equals(`whose Price.Currency is "USD" rather than "usd"`)))
+}
+
 ////////////////////////////////////////////////////////////////////////
 // Benchmarks
 ////////////////////////////////////////////////////////////////////////
@@ -341,3 +432,20 @@ func BenchmarkShortByteSlice(b *testing.B) {
 func BenchmarkLongByteSlice(b *testing.B) {
 	benchmarkWithSize(b, 1<<24)
 }
+
+func BenchmarkLargeMap(b *testing.B) {
+	b.StopTimer()
+	x := make(map[string]int)
+	c := make(map[string]int)
+	for i := 0; i < 10000; i++ {
+		x[fmt.Sprint(i)] = i
+		c[fmt.Sprint(i)] = i
+	}
+
+	matcher := DeepEquals(x)
+	b.StartTimer()
+
+	for i := 0; i < b.N; i++ {
+		matcher.Matches(c)
+	}
+}
@@ -0,0 +1,769 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// A DeepEqualsOption modifies the behavior of DeepEqualsWith.
+type DeepEqualsOption func(*deepEqualsOptions)
+
+type deepEqualsOptions struct {
+	ignoredFields     []string
+	comparers         map[reflect.Type]reflect.Value
+	sorters           map[reflect.Type]reflect.Value
+	floatTolerance    float64
+	compareUnexported bool
+	equateEmpty       bool
+	strict            bool
+
+	// Descriptions of the options, in the order they were supplied.
+	descs []string
+}
+
+// IgnoreFields causes DeepEqualsWith to skip the named struct fields. A name
+// without dots matches a field of that name in any struct, at any depth. A
+// dotted path such as "Order.Customer.ID" matches only the field reached by
+// following those field names from the top-level value, where "*" matches
+// any single field name. Slice, array, and map elements and pointers are
+// transparent to paths, so "Items.ID" matches the ID field of each element of
+// the slice field Items.
+func IgnoreFields(names ...string) DeepEqualsOption {
+	return func(o *deepEqualsOptions) {
+		o.ignoredFields = append(o.ignoredFields, names...)
+		o.descs = append(o.descs, fmt.Sprintf("ignoring fields [%s]", strings.Join(names, ", ")))
+	}
+}
+
+// Comparer causes DeepEqualsWith to compare values of type T using f, which
+// must have the form func(T, T) bool, in place of structural comparison or
+// an Equal method. For example:
+//
+//     DeepEqualsWith(want, Comparer(strings.EqualFold))
+//
+// f is not used for values held in unexported fields, which are compared
+// structurally if CompareUnexported is supplied.
+func Comparer(f interface{}) DeepEqualsOption {
+	v := reflect.ValueOf(f)
+	t := checkBinaryPredicate("Comparer", v)
+
+	return func(o *deepEqualsOptions) {
+		if o.comparers == nil {
+			o.comparers = make(map[reflect.Type]reflect.Value)
+		}
+
+		o.comparers[t] = v
+		o.descs = append(o.descs, fmt.Sprintf("using a comparer for %v", t))
+	}
+}
+
+// FloatTolerance causes DeepEqualsWith to treat floating point values as equal
+// when they differ by at most margin.
+func FloatTolerance(margin float64) DeepEqualsOption {
+	if margin < 0 || math.IsNaN(margin) {
+		panic(fmt.Sprintf("FloatTolerance: invalid margin %v", margin))
+	}
+
+	return func(o *deepEqualsOptions) {
+		o.floatTolerance = margin
+		o.descs = append(o.descs, fmt.Sprintf("with float tolerance %v", margin))
+	}
+}
+
+// CompareUnexported causes DeepEqualsWith to compare unexported struct
+// fields. Without it, reaching an unexported field that isn't covered by
+// IgnoreFields, an Equal method, or a Comparer for its struct type is a fatal
+// error.
+func CompareUnexported() DeepEqualsOption {
+	return func(o *deepEqualsOptions) {
+		o.compareUnexported = true
+		o.descs = append(o.descs, "comparing unexported fields")
+	}
+}
+
+// EquateEmpty causes DeepEqualsWith to treat nil and empty slices as equal,
+// and likewise nil and empty maps.
+func EquateEmpty() DeepEqualsOption {
+	return func(o *deepEqualsOptions) {
+		o.equateEmpty = true
+		o.descs = append(o.descs, "treating nil and empty as equal")
+	}
+}
+
+// StrictEquality causes DeepEqualsWith to ignore Equal methods, comparing all
+// values structurally.
+func StrictEquality() DeepEqualsOption {
+	return func(o *deepEqualsOptions) {
+		o.strict = true
+		o.descs = append(o.descs, "ignoring Equal methods")
+	}
+}
+
+// SortSlices causes DeepEqualsWith to sort slices with element type T before
+// comparing them, using less, which must have the form func(T, T) bool. This
+// makes the comparison insensitive to the order of their elements. Indices in
+// error text refer to the sorted order. For example:
+//
+//     DeepEqualsWith(want, SortSlices(func(a, b string) bool { return a < b }))
+//
+// Slices held in unexported fields are not sorted.
+func SortSlices(less interface{}) DeepEqualsOption {
+	v := reflect.ValueOf(less)
+	t := checkBinaryPredicate("SortSlices", v)
+
+	return func(o *deepEqualsOptions) {
+		if o.sorters == nil {
+			o.sorters = make(map[reflect.Type]reflect.Value)
+		}
+
+		o.sorters[t] = v
+		o.descs = append(o.descs, fmt.Sprintf("sorting slices of %v", t))
+	}
+}
+
+// Panic unless v is a function of the form func(T, T) bool, and return T.
+func checkBinaryPredicate(name string, v reflect.Value) reflect.Type {
+	if v.Kind() != reflect.Func || v.IsNil() {
+		panic(fmt.Sprintf("%s: %v is not a function", name, v))
+	}
+
+	t := v.Type()
+	if t.NumIn() != 2 ||
+		t.In(0) != t.In(1) ||
+		t.IsVariadic() ||
+		t.NumOut() != 1 ||
+		t.Out(0).Kind() != reflect.Bool {
+		panic(fmt.Sprintf("%s: %v is not of the form func(T, T) bool", name, t))
+	}
+
+	return t.In(0)
+}
+
+// DeepEqualsWith returns a matcher like DeepEquals(x), modified by the
+// supplied options. For example, to compare two records while ignoring their
+// timestamps and the order of their tags:
+//
+//     DeepEqualsWith(
+//         want,
+//         IgnoreFields("CreateTime", "UpdateTime"),
+//         SortSlices(func(a, b string) bool { return a < b }))
+//
+// As with DeepEquals, values with an Equal method are compared using it unless
+// StrictEquality is supplied. Unlike DeepEquals, unexported struct fields are
+// compared only if CompareUnexported is supplied; otherwise reaching one that
+// isn't ignored or handled by an Equal method or Comparer is a fatal error,
+// so that differences in them can't go unnoticed.
+//
+// When a nested value differs, the error names its path, e.g.
+// `whose Items[2].Name is "taco" rather than "burrito"`.
+func DeepEqualsWith(x interface{}, opts ...DeepEqualsOption) Matcher {
+	m := &deepEqualsWithMatcher{x: x}
+	for _, o := range opts {
+		o(&m.opts)
+	}
+
+	return m
+}
+
+type deepEqualsWithMatcher struct {
+	x    interface{}
+	opts deepEqualsOptions
+}
+
+func (m *deepEqualsWithMatcher) describe(verb string) string {
+	desc := fmt.Sprintf("%s: %s", verb, FormatValue(m.x))
+	for _, d := range m.opts.descs {
+		desc += ", " + d
+	}
+
+	return desc
+}
+
+func (m *deepEqualsWithMatcher) Description() string {
+	return m.describe("deep equals")
+}
+
+func (m *deepEqualsWithMatcher) DescribeNegation() string {
+	return m.describe("doesn't deep equal")
+}
+
+func (m *deepEqualsWithMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *deepEqualsWithMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	// Make sure the types match.
+	ct := reflect.TypeOf(c)
+	xt := reflect.TypeOf(m.x)
+
+	if ct != xt {
+		return NewFatalError(fmt.Sprintf("which is of type %v", ct))
+	}
+
+	cmp := deepComparison{opts: &m.opts}
+	if cmp.equal(reflect.ValueOf(c), reflect.ValueOf(m.x), "") {
+		return nil
+	}
+
+	return cmp.err()
+}
+
+////////////////////////////////////////////////////////////////////////
+// Comparison
+////////////////////////////////////////////////////////////////////////
+
+// The state of a single deep comparison between a candidate and an expected
+// value.
+type deepComparison struct {
+	opts *deepEqualsOptions
+
+	// Whether to compare pointers by identity rather than following them, as
+	// with EqualsSemantic.
+	shallow bool
+
+	// Pairs of references already compared, for cycle detection, each with the
+	// number of pairs visited before it.
+	visited map[deepVisit]int
+
+	// The nesting depth of the values currently being compared, zero for the
+	// candidate itself.
+	depth int
+
+	// The path to the first difference found, and a phrase describing it such
+	// as "has length 2". An empty phrase means the values simply differ. The
+	// path is built up only once a difference is found, as the comparison
+	// unwinds.
+	diffPath   string
+	diffPhrase string
+
+	// Whether the difference is one that should be reported as a fatal error.
+	fatal bool
+}
+
+type deepVisit struct {
+	a, b uintptr
+	n    int
+	t    reflect.Type
+}
+
+// Return an error describing the difference found.
+func (c *deepComparison) err() error {
+	var s string
+	switch {
+	case c.diffPath != "":
+		s = fmt.Sprintf("whose %s %s", strings.TrimPrefix(c.diffPath, "."), c.diffPhrase)
+
+	case c.diffPhrase != "":
+		s = "which " + c.diffPhrase
+
+	default:
+		return errNoMatch
+	}
+
+	if c.fatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
+
+// Record a difference in the values currently being compared, returning
+// false for convenience.
+func (c *deepComparison) fail(format string, args ...interface{}) bool {
+	c.diffPath = ""
+	c.diffPhrase = fmt.Sprintf(format, args...)
+	return false
+}
+
+// Record a difference between the values a and b.
+func (c *deepComparison) failValues(a, b reflect.Value) bool {
+	if c.depth == 0 {
+		return c.fail("")
+	}
+
+	return c.fail("is %s rather than %s", formatReflectValue(a), formatReflectValue(b))
+}
+
+// Compare values nested one level within those currently being compared.
+func (c *deepComparison) nested(a, b reflect.Value, fieldPath string) bool {
+	c.depth++
+	ok := c.equal(a, b, fieldPath)
+	c.depth--
+
+	return ok
+}
+
+// Prefix the path to the difference found with the supplied segment, such as
+// "[2]" or ".Name", returning false for convenience.
+func (c *deepComparison) within(segment string) bool {
+	c.diffPath = segment + c.diffPath
+	return false
+}
+
+// Like FormatValue, but for values that may have been read from unexported
+// fields.
+func formatReflectValue(v reflect.Value) string {
+	switch {
+	case !v.IsValid():
+		return "nil"
+
+	case v.CanInterface():
+		return FormatValue(v.Interface())
+	}
+
+	// Values read from unexported fields can't be converted to interface{}, but
+	// scalars may be copied into values that can.
+	c := reflect.New(v.Type()).Elem()
+	switch v.Kind() {
+	case reflect.Bool:
+		c.SetBool(v.Bool())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		c.SetInt(v.Int())
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		c.SetUint(v.Uint())
+
+	case reflect.Float32, reflect.Float64:
+		c.SetFloat(v.Float())
+
+	case reflect.Complex64, reflect.Complex128:
+		c.SetComplex(v.Complex())
+
+	case reflect.String:
+		c.SetString(v.String())
+
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+
+	return FormatValue(c.Interface())
+}
+
+// Return true if the candidate a is equal to the expected value b. fieldPath
+// is the dotted path of struct field names leading to them, used for
+// IgnoreFields.
+func (c *deepComparison) equal(a, b reflect.Value, fieldPath string) bool {
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() == b.IsValid() {
+			return true
+		}
+
+		return c.failValues(a, b)
+	}
+
+	if a.Type() != b.Type() {
+		return c.fail("has type %v rather than %v", a.Type(), b.Type())
+	}
+
+	// Custom comparers take precedence.
+	if f, ok := c.opts.comparers[a.Type()]; ok && a.CanInterface() {
+		if f.Call([]reflect.Value{a, b})[0].Bool() {
+			return true
+		}
+
+		return c.failValues(a, b)
+	}
+
+	// Then Equal methods, unless in strict mode. Nil values are compared
+	// without calling the method, which may not expect them.
+	if u, ok := equalMethodArg(a.Type()); ok && !c.opts.strict && a.CanInterface() && a.Type().AssignableTo(u) {
+		if isNillable(a.Kind()) {
+			if ok, done := c.compareNil(a, b); done {
+				return ok
+			}
+		}
+
+		if a.MethodByName("Equal").Call([]reflect.Value{b})[0].Bool() {
+			return true
+		}
+
+		return c.failValues(a, b)
+	}
+
+	// In shallow mode references are compared by identity, as with ==.
+	if c.shallow {
+		switch a.Kind() {
+		case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+			if a.Pointer() == b.Pointer() {
+				return true
+			}
+
+			return c.failValues(a, b)
+
+		case reflect.Slice, reflect.Map, reflect.Func:
+			return c.fail("is of uncomparable type %v", a.Type())
+		}
+	}
+
+	switch a.Kind() {
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if !c.nested(a.Index(i), b.Index(i), fieldPath) {
+				return c.within(fmt.Sprintf("[%d]", i))
+			}
+		}
+
+		return true
+
+	case reflect.Slice:
+		if c.opts.equateEmpty && a.Len() == 0 && b.Len() == 0 {
+			return true
+		}
+
+		if ok, done := c.compareNil(a, b); done {
+			return ok
+		}
+
+		if a.Len() != b.Len() {
+			return c.fail("has length %d rather than %d", a.Len(), b.Len())
+		}
+
+		if a.Pointer() == b.Pointer() || c.seen(a, b) {
+			return true
+		}
+
+		a, b = c.sorted(a), c.sorted(b)
+		for i := 0; i < a.Len(); i++ {
+			if !c.nested(a.Index(i), b.Index(i), fieldPath) {
+				return c.within(fmt.Sprintf("[%d]", i))
+			}
+		}
+
+		return true
+
+	case reflect.Map:
+		if c.opts.equateEmpty && a.Len() == 0 && b.Len() == 0 {
+			return true
+		}
+
+		if ok, done := c.compareNil(a, b); done {
+			return ok
+		}
+
+		if a.Len() != b.Len() {
+			return c.fail("has length %d rather than %d", a.Len(), b.Len())
+		}
+
+		if a.Pointer() == b.Pointer() || c.seen(a, b) {
+			return true
+		}
+
+		// Walk the map in iteration order, but if there is a difference find
+		// the first in key order, so that the error is deterministic.
+		visits := len(c.visited)
+		if c.equalMapEntries(a, b, b.MapKeys(), fieldPath) {
+			return true
+		}
+
+		c.forgetVisits(visits)
+		c.fatal = false
+		return c.equalMapEntries(a, b, sortedMapKeys(b), fieldPath)
+
+	case reflect.Ptr:
+		if ok, done := c.compareNil(a, b); done {
+			return ok
+		}
+
+		if a.Pointer() == b.Pointer() || c.seen(a, b) {
+			return true
+		}
+
+		return c.equal(a.Elem(), b.Elem(), fieldPath)
+
+	case reflect.Interface:
+		if ok, done := c.compareNil(a, b); done {
+			return ok
+		}
+
+		return c.equal(a.Elem(), b.Elem(), fieldPath)
+
+	case reflect.Struct:
+		t := a.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+
+			// Field paths are needed only for IgnoreFields.
+			var fp string
+			if len(c.opts.ignoredFields) != 0 {
+				fp = f.Name
+				if fieldPath != "" {
+					fp = fieldPath + "." + f.Name
+				}
+
+				if c.opts.ignored(f.Name, fp) {
+					continue
+				}
+			}
+
+			// Rather than silently skipping unexported fields, insist that the
+			// user say what to do with them.
+			if f.PkgPath != "" && !c.opts.compareUnexported {
+				c.fatal = true
+				c.fail("is an unexported field of %v, which requires CompareUnexported", t)
+				return c.within("." + f.Name)
+			}
+
+			if !c.nested(a.Field(i), b.Field(i), fp) {
+				return c.within("." + f.Name)
+			}
+		}
+
+		return true
+
+	case reflect.Func:
+		// As with reflect.DeepEqual, functions are equal only if both are nil.
+		if a.IsNil() && b.IsNil() {
+			return true
+		}
+
+		return c.failValues(a, b)
+
+	case reflect.Chan, reflect.UnsafePointer:
+		if a.Pointer() == b.Pointer() {
+			return true
+		}
+
+		return c.failValues(a, b)
+
+	case reflect.Float32, reflect.Float64:
+		// The tolerance applies only to finite values, since the difference
+		// between equal infinities is NaN.
+		af, bf := a.Float(), b.Float()
+		if af == bf {
+			return true
+		}
+
+		finite := !math.IsInf(af, 0) && !math.IsInf(bf, 0)
+		if finite && math.Abs(af-bf) <= c.opts.floatTolerance {
+			return true
+		}
+
+		return c.failValues(a, b)
+
+	case reflect.Bool:
+		if a.Bool() == b.Bool() {
+			return true
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if a.Int() == b.Int() {
+			return true
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if a.Uint() == b.Uint() {
+			return true
+		}
+
+	case reflect.Complex64, reflect.Complex128:
+		if a.Complex() == b.Complex() {
+			return true
+		}
+
+	case reflect.String:
+		if a.String() == b.String() {
+			return true
+		}
+	}
+
+	return c.failValues(a, b)
+}
+
+// If exactly one of a and b is nil, record a difference. Return the result
+// of the comparison and true if it has been decided by nilness alone.
+func (c *deepComparison) compareNil(a, b reflect.Value) (ok bool, done bool) {
+	switch {
+	case a.IsNil() && b.IsNil():
+		return true, true
+
+	case a.IsNil() && c.depth == 0:
+		return c.fail("is nil"), true
+
+	case a.IsNil():
+		return c.fail("is nil rather than %s", formatReflectValue(b)), true
+
+	case b.IsNil():
+		return c.fail("is non-nil"), true
+	}
+
+	return false, false
+}
+
+// Compare the entries of the maps a and b, which have the same length, with
+// the supplied keys of b in order.
+func (c *deepComparison) equalMapEntries(a, b reflect.Value, keys []reflect.Value, fieldPath string) bool {
+	for _, k := range keys {
+		av := a.MapIndex(k)
+		if !av.IsValid() {
+			return c.fail("is missing key %s", formatReflectValue(k))
+		}
+
+		if !c.nested(av, b.MapIndex(k), fieldPath) {
+			return c.within("[" + formatReflectValue(k) + "]")
+		}
+	}
+
+	return true
+}
+
+// If values of type t have a method of the form Equal(U) bool, return U.
+func equalMethodArg(t reflect.Type) (reflect.Type, bool) {
+	m, ok := t.MethodByName("Equal")
+	if !ok {
+		return nil, false
+	}
+
+	// Method types include the receiver, except for interfaces.
+	mt := m.Type
+	in := 1
+	if t.Kind() == reflect.Interface {
+		in = 0
+	}
+
+	if mt.NumIn() != in+1 || mt.IsVariadic() || mt.NumOut() != 1 || mt.Out(0).Kind() != reflect.Bool {
+		return nil, false
+	}
+
+	return mt.In(in), true
+}
+
+func isNillable(k reflect.Kind) bool {
+	switch k {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return true
+	}
+
+	return false
+}
+
+// Return true if the references a and b are already being compared, marking
+// them as such otherwise. A cycle is assumed to be equal; any difference will
+// be found elsewhere.
+func (c *deepComparison) seen(a, b reflect.Value) bool {
+	v := deepVisit{a.Pointer(), b.Pointer(), 0, a.Type()}
+	if a.Kind() == reflect.Slice {
+		v.n = a.Len()
+	}
+
+	if _, ok := c.visited[v]; ok {
+		return true
+	}
+
+	if c.visited == nil {
+		c.visited = make(map[deepVisit]int)
+	}
+
+	c.visited[v] = len(c.visited)
+	return false
+}
+
+// Forget all but the first n pairs of references visited, so that they may be
+// compared again.
+func (c *deepComparison) forgetVisits(n int) {
+	for v, i := range c.visited {
+		if i >= n {
+			delete(c.visited, v)
+		}
+	}
+}
+
+// Return a sorted copy of the slice v if SortSlices applies to it, and v
+// otherwise.
+func (c *deepComparison) sorted(v reflect.Value) reflect.Value {
+	less, ok := c.opts.sorters[v.Type().Elem()]
+	if !ok || !v.CanInterface() {
+		return v
+	}
+
+	s := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	reflect.Copy(s, v)
+
+	sort.SliceStable(s.Interface(), func(i, j int) bool {
+		return less.Call([]reflect.Value{s.Index(i), s.Index(j)})[0].Bool()
+	})
+
+	return s
+}
+
+// Return the keys of the map v, in the order of their printed
+// representations so that error text is deterministic.
+func sortedMapKeys(v reflect.Value) []reflect.Value {
+	type entry struct {
+		printedKey string
+		key        reflect.Value
+	}
+
+	entries := make([]entry, 0, v.Len())
+	for _, k := range v.MapKeys() {
+		entries = append(entries, entry{formatReflectValue(k), k})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].printedKey < entries[j].printedKey
+	})
+
+	keys := make([]reflect.Value, len(entries))
+	for i, e := range entries {
+		keys[i] = e.key
+	}
+
+	return keys
+}
+
+// Return true if the struct field with the supplied name, at the supplied
+// dotted field path, should be skipped.
+func (o *deepEqualsOptions) ignored(name, fieldPath string) bool {
+	for _, pattern := range o.ignoredFields {
+		if !strings.Contains(pattern, ".") {
+			if pattern == name {
+				return true
+			}
+
+			continue
+		}
+
+		if matchFieldPath(strings.Split(pattern, "."), strings.Split(fieldPath, ".")) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Return true if the field path matches the pattern, with "*" in the pattern
+// matching any one field name.
+func matchFieldPath(pattern, path []string) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+
+	for i := range pattern {
+		if pattern[i] != "*" && pattern[i] != path[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,384 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type DeepEqualsWithTest struct {
+}
+
+func init() { RegisterTestSuite(&DeepEqualsWithTest{}) }
+
+type deepEqualsWithItem struct {
+	Name  string
+	ID    int
+	Price float64
+}
+
+type deepEqualsWithOrder struct {
+	ID         int
+	Customer   *deepEqualsWithItem
+	Items      []deepEqualsWithItem
+	Tags       []string
+	Attrs      map[string]int
+	CreateTime time.Time
+}
+
+type deepEqualsWithNote struct {
+	Text string
+	note string
+}
+
+func newDeepEqualsWithOrder() deepEqualsWithOrder {
+	return deepEqualsWithOrder{
+		ID:       17,
+		Customer: &deepEqualsWithItem{Name: "alice", ID: 1},
+		Items: []deepEqualsWithItem{
+			{Name: "taco", ID: 2, Price: 1.5},
+			{Name: "burrito", ID: 3, Price: 7.25},
+		},
+		Tags:       []string{"a", "b"},
+		Attrs:      map[string]int{"x": 1},
+		CreateTime: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func stringLess(a, b string) bool { return a < b }
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *DeepEqualsWithTest) Descriptions() {
+	m := DeepEqualsWith(17)
+	ExpectEq("deep equals: 17", m.Description())
+	ExpectEq("doesn't deep equal: 17", Not(m).Description())
+
+	m = DeepEqualsWith(
+		17,
+		IgnoreFields("A", "B.C"),
+		Comparer(func(a, b time.Time) bool { return a.Equal(b) }),
+		FloatTolerance(0.5),
+		CompareUnexported(),
+		EquateEmpty(),
+		SortSlices(stringLess))
+
+	ExpectEq(
+		"deep equals: 17, ignoring fields [A, B.C], "+
+			"using a comparer for time.Time, with float tolerance 0.5, "+
+			"comparing unexported fields, treating nil and empty as equal, "+
+			"sorting slices of string",
+		m.Description())
+}
+
+func (t *DeepEqualsWithTest) InvalidOptionsPanic() {
+	ExpectThat(func() { Comparer(17) }, Panics(HasSubstr("17 is not a function")))
+	ExpectThat(func() { Comparer(nil) }, Panics(HasSubstr("is not a function")))
+	ExpectThat(
+		func() { Comparer(func(a int, b string) bool { return false }) },
+		Panics(HasSubstr("func(int, string) bool is not of the form func(T, T) bool")))
+
+	ExpectThat(
+		func() { SortSlices(func(a, b int) int { return 0 }) },
+		Panics(HasSubstr("SortSlices: func(int, int) int is not of the form")))
+
+	ExpectThat(func() { FloatTolerance(-1) }, Panics(HasSubstr("invalid margin -1")))
+}
+
+func (t *DeepEqualsWithTest) WrongTypeCandidate() {
+	m := DeepEqualsWith(newDeepEqualsWithOrder())
+
+	err := m.Matches(17)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is of type int")))
+
+	err = m.Matches(nil)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is of type <nil>")))
+}
+
+func (t *DeepEqualsWithTest) NoOptions() {
+	m := DeepEqualsWith(newDeepEqualsWithOrder())
+	ExpectEq(nil, m.Matches(newDeepEqualsWithOrder()))
+
+	// Differences are reported by path.
+	c := newDeepEqualsWithOrder()
+	c.Items[1].Name = "enchilada"
+	ExpectThat(m.Matches(c), Error(Equals(`whose Items[1].Name is "enchilada" rather than "burrito"`)))
+
+	c = newDeepEqualsWithOrder()
+	c.Customer.ID = 2
+	ExpectThat(m.Matches(c), Error(Equals("whose Customer.ID is 2 rather than 1")))
+
+	c = newDeepEqualsWithOrder()
+	c.Tags = c.Tags[:1]
+	ExpectThat(m.Matches(c), Error(Equals("whose Tags has length 1 rather than 2")))
+
+	c = newDeepEqualsWithOrder()
+	c.Tags = nil
+	ExpectThat(m.Matches(c), Error(Equals(`whose Tags is nil rather than ["a" "b"]`)))
+
+	c = newDeepEqualsWithOrder()
+	c.Attrs = map[string]int{"y": 1}
+	ExpectThat(m.Matches(c), Error(Equals(`whose Attrs is missing key "x"`)))
+
+	c = newDeepEqualsWithOrder()
+	c.Attrs = map[string]int{"x": 2}
+	ExpectThat(m.Matches(c), Error(Equals(`whose Attrs["x"] is 2 rather than 1`)))
+
+	c = newDeepEqualsWithOrder()
+	c.Customer = nil
+	ExpectThat(m.Matches(c), Error(HasSubstr("whose Customer is nil rather than")))
+}
+
+func (t *DeepEqualsWithTest) TopLevelDifferences() {
+	err := DeepEqualsWith(17).Matches(18)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("")))
+
+	err = DeepEqualsWith([]int{1}).Matches([]int(nil))
+	ExpectThat(err, Error(Equals("which is nil")))
+
+	err = DeepEqualsWith([]int(nil)).Matches([]int{})
+	ExpectThat(err, Error(Equals("which is non-nil")))
+
+	err = DeepEqualsWith([]int{1}).Matches([]int{1, 2})
+	ExpectThat(err, Error(Equals("which has length 2 rather than 1")))
+
+	ExpectEq(nil, DeepEqualsWith(nil).Matches(nil))
+}
+
+func (t *DeepEqualsWithTest) InterfaceElements() {
+	m := DeepEqualsWith([]interface{}{1, "taco"})
+	ExpectEq(nil, m.Matches([]interface{}{1, "taco"}))
+
+	err := m.Matches([]interface{}{1, 2})
+	ExpectThat(err, Error(Equals("whose [1] has type int rather than string")))
+
+	err = m.Matches([]interface{}{1, nil})
+	ExpectThat(err, Error(Equals(`whose [1] is nil rather than "taco"`)))
+}
+
+func (t *DeepEqualsWithTest) IgnoreFieldsByName() {
+	m := DeepEqualsWith(newDeepEqualsWithOrder(), IgnoreFields("ID", "CreateTime"))
+
+	c := newDeepEqualsWithOrder()
+	c.ID = 18
+	c.Customer.ID = 19
+	c.Items[0].ID = 20
+	c.CreateTime = time.Now()
+	ExpectEq(nil, m.Matches(c))
+
+	c.Items[0].Name = "nachos"
+	ExpectThat(m.Matches(c), Error(HasSubstr("whose Items[0].Name is")))
+}
+
+func (t *DeepEqualsWithTest) IgnoreFieldsByPath() {
+	m := DeepEqualsWith(newDeepEqualsWithOrder(), IgnoreFields("Items.ID"))
+
+	c := newDeepEqualsWithOrder()
+	c.Items[0].ID = 20
+	c.Items[1].ID = 21
+	ExpectEq(nil, m.Matches(c))
+
+	// Other fields with the same name are still compared.
+	c.Customer.ID = 22
+	ExpectThat(m.Matches(c), Error(Equals("whose Customer.ID is 22 rather than 1")))
+
+	// Wildcards match any one field name.
+	m = DeepEqualsWith(newDeepEqualsWithOrder(), IgnoreFields("*.ID"))
+	c.ID = 23
+	ExpectThat(m.Matches(c), Error(Equals("whose ID is 23 rather than 17")))
+
+	c.ID = 17
+	ExpectEq(nil, m.Matches(c))
+}
+
+func (t *DeepEqualsWithTest) ComparerForTimes() {
+	want := newDeepEqualsWithOrder()
+	m := DeepEqualsWith(want, Comparer(func(a, b time.Time) bool { return a.Equal(b) }))
+
+	// The same instant in another location.
+	c := newDeepEqualsWithOrder()
+	c.CreateTime = want.CreateTime.In(time.FixedZone("x", 3600))
+	ExpectEq(nil, m.Matches(c))
+	ExpectNe(nil, DeepEqualsWith(want, CompareUnexported(), StrictEquality()).Matches(c))
+
+	c.CreateTime = c.CreateTime.Add(time.Second)
+	ExpectThat(m.Matches(c), Error(HasSubstr("whose CreateTime is")))
+}
+
+func (t *DeepEqualsWithTest) ComparerForFloatTolerance() {
+	m := DeepEqualsWith(
+		[]float64{1, 2},
+		Comparer(func(a, b float64) bool { return math.Abs(a-b) < 0.1 }))
+
+	ExpectEq(nil, m.Matches([]float64{1.05, 1.95}))
+	ExpectThat(m.Matches([]float64{1, 2.5}), Error(Equals("whose [1] is 2.5 rather than 2")))
+}
+
+func (t *DeepEqualsWithTest) FloatTolerance() {
+	m := DeepEqualsWith(newDeepEqualsWithOrder(), FloatTolerance(0.01))
+
+	c := newDeepEqualsWithOrder()
+	c.Items[0].Price = 1.505
+	ExpectEq(nil, m.Matches(c))
+
+	c.Items[0].Price = 1.52
+	ExpectThat(m.Matches(c), Error(Equals("whose Items[0].Price is 1.52 rather than 1.5")))
+
+	// NaN is never equal.
+	ExpectNe(nil, DeepEqualsWith(math.NaN(), FloatTolerance(1)).Matches(math.NaN()))
+
+	// float32 values are also affected.
+	ExpectEq(nil, DeepEqualsWith(float32(1), FloatTolerance(0.01)).Matches(float32(1.001)))
+}
+
+func (t *DeepEqualsWithTest) UnexportedFieldsAreFatalByDefault() {
+	x := []deepEqualsWithNote{{"taco", "a"}}
+	m := DeepEqualsWith(x)
+
+	// Even equal values are rejected, rather than risking a false pass.
+	err := m.Matches([]deepEqualsWithNote{{"taco", "a"}})
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals(
+		"whose [0].note is an unexported field of oglematchers_test.deepEqualsWithNote, "+
+			"which requires CompareUnexported")))
+
+	// Differences in exported fields found first are reported as usual.
+	err = m.Matches([]deepEqualsWithNote{{"burrito", "a"}})
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals(`whose [0].Text is "burrito" rather than "taco"`)))
+
+	// Ignored fields and comparers avoid the error.
+	ExpectEq(nil, DeepEqualsWith(x, IgnoreFields("note")).Matches([]deepEqualsWithNote{{"taco", "b"}}))
+
+	byText := func(a, b deepEqualsWithNote) bool { return a.Text == b.Text }
+	ExpectEq(nil, DeepEqualsWith(x, Comparer(byText)).Matches([]deepEqualsWithNote{{"taco", "b"}}))
+}
+
+func (t *DeepEqualsWithTest) CompareUnexported() {
+	m := DeepEqualsWith(deepEqualsWithNote{"taco", "a"}, CompareUnexported())
+	ExpectEq(nil, m.Matches(deepEqualsWithNote{"taco", "a"}))
+
+	err := m.Matches(deepEqualsWithNote{"taco", "b"})
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals(`whose note is "b" rather than "a"`)))
+}
+
+func (t *DeepEqualsWithTest) Infinities() {
+	inf := math.Inf(1)
+	m := DeepEqualsWith([]float64{inf, -inf}, FloatTolerance(0.5))
+
+	ExpectEq(nil, m.Matches([]float64{inf, -inf}))
+	ExpectThat(m.Matches([]float64{inf, inf}), Error(Equals("whose [1] is +Inf rather than -Inf")))
+	ExpectThat(m.Matches([]float64{math.MaxFloat64, -inf}), Error(HasSubstr("whose [0] is")))
+
+	type bounds struct {
+		Lo, Hi float64
+	}
+
+	ExpectEq(nil, DeepEqualsWith(bounds{-inf, inf}).Matches(bounds{-inf, inf}))
+	ExpectNe(nil, DeepEqualsWith(bounds{-inf, inf}).Matches(bounds{-inf, 17}))
+}
+
+func (t *DeepEqualsWithTest) EquateEmpty() {
+	want := newDeepEqualsWithOrder()
+	want.Tags = nil
+	want.Attrs = map[string]int{}
+
+	c := newDeepEqualsWithOrder()
+	c.Tags = []string{}
+	c.Attrs = nil
+
+	ExpectNe(nil, DeepEqualsWith(want).Matches(c))
+	ExpectEq(nil, DeepEqualsWith(want, EquateEmpty()).Matches(c))
+
+	c.Tags = []string{"a"}
+	ExpectThat(
+		DeepEqualsWith(want, EquateEmpty()).Matches(c),
+		Error(Equals("whose Tags is non-nil")))
+}
+
+func (t *DeepEqualsWithTest) SortSlices() {
+	m := DeepEqualsWith(newDeepEqualsWithOrder(), SortSlices(stringLess))
+
+	c := newDeepEqualsWithOrder()
+	c.Tags = []string{"b", "a"}
+	ExpectEq(nil, m.Matches(c))
+
+	// The candidate itself isn't modified.
+	ExpectThat(c.Tags, ElementsAre("b", "a"))
+
+	c.Tags = []string{"c", "a"}
+	ExpectThat(m.Matches(c), Error(Equals(`whose Tags[1] is "c" rather than "b"`)))
+
+	// Slices of other types are unaffected.
+	c = newDeepEqualsWithOrder()
+	c.Items[0], c.Items[1] = c.Items[1], c.Items[0]
+	ExpectThat(m.Matches(c), Error(HasSubstr("whose Items[0].Name is")))
+}
+
+func (t *DeepEqualsWithTest) Cycles() {
+	type node struct {
+		Val  int
+		Next *node
+	}
+
+	a := &node{Val: 1}
+	a.Next = a
+
+	b := &node{Val: 1}
+	b.Next = &node{Val: 1, Next: b}
+
+	ExpectEq(nil, DeepEqualsWith(a).Matches(b))
+
+	b.Next.Val = 2
+	ExpectThat(DeepEqualsWith(a).Matches(b), Error(Equals("whose Next.Val is 2 rather than 1")))
+}
+
+func (t *DeepEqualsWithTest) MapDifferencesAreReportedInKeyOrder() {
+	type item struct {
+		Name string
+	}
+
+	x := make(map[string]*item)
+	c := make(map[string]*item)
+	for i := 0; i < 50; i++ {
+		k := fmt.Sprintf("k%02d", i)
+		x[k] = &item{Name: "taco"}
+		c[k] = &item{Name: "taco"}
+	}
+
+	c["k01"].Name = "burrito"
+	c["k49"].Name = "burrito"
+
+	m := DeepEqualsWith(x)
+	for i := 0; i < 20; i++ {
+		ExpectThat(m.Matches(c), Error(Equals(`whose ["k01"].Name is "burrito" rather than "taco"`)))
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The number of unchanged lines shown around each change in a unified diff.
+const diffContext = 3
+
+// An operation in an edit script turning one list of lines into another.
+type diffOp struct {
+	kind byte // ' ', '-', or '+'
+	line string
+}
+
+// unifiedDiff returns a unified diff turning a into b, with the supplied
+// names in the header. It returns the empty string if a and b are equal.
+func unifiedDiff(aName, bName, a, b string) string {
+	if a == b {
+		return ""
+	}
+
+	ops := diffLines(splitLines(a), splitLines(b))
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", aName, bName)
+
+	// Find runs of changes, along with their context.
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+
+		// Extend the hunk while the next change is close enough that the
+		// contexts would overlap.
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+
+		end := i
+		for {
+			for end < len(ops) && ops[end].kind != ' ' {
+				end++
+			}
+
+			next := end
+			for next < len(ops) && ops[next].kind == ' ' {
+				next++
+			}
+
+			if next == len(ops) || next-end > 2*diffContext {
+				break
+			}
+
+			end = next
+		}
+
+		end += diffContext
+		if end > len(ops) {
+			end = len(ops)
+		}
+
+		writeHunk(&out, ops, start, end)
+		i = end
+	}
+
+	return out.String()
+}
+
+func writeHunk(out *strings.Builder, ops []diffOp, start, end int) {
+	// Compute line numbers by counting the lines before the hunk.
+	aStart, bStart := 1, 1
+	for _, op := range ops[:start] {
+		if op.kind != '+' {
+			aStart++
+		}
+
+		if op.kind != '-' {
+			bStart++
+		}
+	}
+
+	var aLen, bLen int
+	for _, op := range ops[start:end] {
+		if op.kind != '+' {
+			aLen++
+		}
+
+		if op.kind != '-' {
+			bLen++
+		}
+	}
+
+	fmt.Fprintf(
+		out,
+		"@@ -%s +%s @@\n",
+		hunkRange(aStart, aLen),
+		hunkRange(bStart, bLen))
+
+	for _, op := range ops[start:end] {
+		out.WriteByte(op.kind)
+		out.WriteString(op.line)
+		if !strings.HasSuffix(op.line, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// Format a line range in the manner of GNU diff.
+func hunkRange(start, length int) string {
+	switch length {
+	case 0:
+		return fmt.Sprintf("%d,0", start-1)
+
+	case 1:
+		return fmt.Sprintf("%d", start)
+	}
+
+	return fmt.Sprintf("%d,%d", start, length)
+}
+
+// Split s into lines, each including its terminating newline if any.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// diffLines computes a shortest edit script turning a into b using the
+// linear-space variant of Myers' algorithm, so that memory use doesn't grow
+// with the square of the number of differences.
+func diffLines(a, b []string) []diffOp {
+	return appendDiff(nil, a, b)
+}
+
+// Append a shortest edit script turning a into b to ops.
+func appendDiff(ops []diffOp, a, b []string) []diffOp {
+	// Strip the common prefix and suffix, which keeps the search below small
+	// in the common case of localized changes, and ensures that it makes
+	// progress.
+	var prefix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	var suffix int
+	for suffix < len(a)-prefix &&
+		suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	for _, line := range a[:prefix] {
+		ops = append(ops, diffOp{' ', line})
+	}
+
+	midA := a[prefix : len(a)-suffix]
+	midB := b[prefix : len(b)-suffix]
+
+	switch {
+	case len(midA) == 0:
+		for _, line := range midB {
+			ops = append(ops, diffOp{'+', line})
+		}
+
+	case len(midB) == 0:
+		for _, line := range midA {
+			ops = append(ops, diffOp{'-', line})
+		}
+
+	default:
+		// With the ends stripped, at least two edits are needed, so each side
+		// of the middle snake needs fewer than the whole.
+		x, y, u, v := middleSnake(midA, midB)
+		ops = appendDiff(ops, midA[:x], midB[:y])
+		for _, line := range midA[x:u] {
+			ops = append(ops, diffOp{' ', line})
+		}
+
+		ops = appendDiff(ops, midA[u:], midB[v:])
+	}
+
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{' ', line})
+	}
+
+	return ops
+}
+
+// Find the middle snake of a shortest edit script turning a into b, as in
+// section 4b of Myers' paper, by searching forward from the start and
+// backward from the end at once until the paths overlap. Return the points
+// (x, y) and (u, v) at which the snake starts and ends.
+func middleSnake(a, b []string) (x, y, u, v int) {
+	n, m := len(a), len(b)
+	delta := n - m
+	odd := delta%2 != 0
+
+	// forward[offset+k] is the furthest x reached on diagonal k = x-y going
+	// forward, and backward[offset+k] the furthest distance reached from the
+	// end on diagonal k = (n-x)-(m-y) going backward.
+	max := (n + m + 1) / 2
+	offset := max + 1
+	forward := make([]int, 2*max+3)
+	backward := make([]int, 2*max+3)
+
+	for d := 0; d <= max; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && forward[offset+k-1] < forward[offset+k+1]) {
+				x = forward[offset+k+1]
+			} else {
+				x = forward[offset+k-1] + 1
+			}
+
+			y := x - k
+			x0, y0 := x, y
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+
+			forward[offset+k] = x
+
+			// Check for overlap with the backward paths of the previous round.
+			if kb := delta - k; odd && kb >= -(d-1) && kb <= d-1 {
+				if x+backward[offset+kb] >= n {
+					return x0, y0, x, y
+				}
+			}
+		}
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && backward[offset+k-1] < backward[offset+k+1]) {
+				x = backward[offset+k+1]
+			} else {
+				x = backward[offset+k-1] + 1
+			}
+
+			y := x - k
+			x0, y0 := x, y
+			for x < n && y < m && a[n-1-x] == b[m-1-y] {
+				x++
+				y++
+			}
+
+			backward[offset+k] = x
+
+			// Check for overlap with the forward paths of this round.
+			if kf := delta - k; !odd && kf >= -d && kf <= d {
+				if forward[offset+kf]+x >= n {
+					return n - x, m - y, n - x0, m - y0
+				}
+			}
+		}
+	}
+
+	panic("middleSnake: no path found")
+}
@@ -0,0 +1,149 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Each returns a matcher that matches collections and maps for which every
+// element (or every value, in the case of maps) matches the supplied argument.
+// If the argument x is not itself a Matcher, this is equivalent to
+// Each(Equals(x)). Empty collections are matched.
+//
+// For example:
+//
+//     Each(GreaterThan(0))  // matches []int{1, 2, 3}
+//     Each(GreaterThan(0))  // doesn't match []int{1, 0, 3}
+//     Each(HasSubstr("a"))  // matches map[int]string{1: "taco", 2: "salsa"}
+//
+func Each(x interface{}) Matcher {
+	var result eachMatcher
+	var ok bool
+
+	if result.elementMatcher, ok = x.(Matcher); !ok {
+		result.elementMatcher = Equals(x)
+	}
+
+	return &result
+}
+
+// None returns a matcher that matches collections and maps for which no
+// element (or no value, in the case of maps) matches the supplied argument. If
+// the argument x is not itself a Matcher, this is equivalent to
+// None(Equals(x)). Empty collections are matched.
+//
+// Unlike Not(Contains(x)), fatal errors returned by the element matcher are
+// propagated rather than being treated as non-matches.
+func None(x interface{}) Matcher {
+	var result noneMatcher
+	var ok bool
+
+	if result.elementMatcher, ok = x.(Matcher); !ok {
+		result.elementMatcher = Equals(x)
+	}
+
+	return &result
+}
+
+type eachMatcher struct {
+	elementMatcher Matcher
+}
+
+func (m *eachMatcher) Description() string {
+	return fmt.Sprintf("each element: %s", m.elementMatcher.Description())
+}
+
+func (m *eachMatcher) DescribeNegation() string {
+	return fmt.Sprintf("doesn't have each element: %s", m.elementMatcher.Description())
+}
+
+func (m *eachMatcher) Matches(candidate interface{}) error {
+	return observeMatch(nil, m, candidate)
+}
+
+func (m *eachMatcher) observedMatches(
+	o *observation,
+	candidate interface{}) (err error) {
+	return forEachElement(candidate, func(pos elementPos, elem interface{}) error {
+		matchErr := o.matches(m.elementMatcher, elem)
+		if matchErr == nil {
+			return nil
+		}
+
+		// Return an error indicating which element doesn't match. If the matcher
+		// error was fatal, make this one fatal too.
+		err := errors.New(fmt.Sprintf("whose %s doesn't match", pos.name()))
+		if _, isFatal := matchErr.(*FatalError); isFatal {
+			err = NewFatalError(err.Error())
+		}
+
+		return err
+	})
+}
+
+type noneMatcher struct {
+	elementMatcher Matcher
+}
+
+func (m *noneMatcher) Description() string {
+	return fmt.Sprintf("no element: %s", m.elementMatcher.Description())
+}
+
+func (m *noneMatcher) DescribeNegation() string {
+	return fmt.Sprintf("has an element: %s", m.elementMatcher.Description())
+}
+
+func (m *noneMatcher) Matches(candidate interface{}) error {
+	return observeMatch(nil, m, candidate)
+}
+
+func (m *noneMatcher) observedMatches(
+	o *observation,
+	candidate interface{}) (err error) {
+	return forEachElement(candidate, func(pos elementPos, elem interface{}) error {
+		matchErr := o.matches(m.elementMatcher, elem)
+		if matchErr == nil {
+			return errors.New(fmt.Sprintf("whose %s matches", pos.name()))
+		}
+
+		if _, isFatal := matchErr.(*FatalError); isFatal {
+			return NewFatalError(fmt.Sprintf("whose %s doesn't match", pos.name()))
+		}
+
+		return nil
+	})
+}
+
+// Call f for each element of the supplied collection, or each value of the
+// supplied map, stopping at the first non-nil error and returning it.
+func forEachElement(
+	candidate interface{},
+	f func(pos elementPos, elem interface{}) error) error {
+	src, ok := newElementSource(candidate, true)
+	if !ok {
+		return notACollection(true)
+	}
+
+	var err error
+	n := src.visit(func(pos elementPos, elem interface{}) bool {
+		err = f(pos, elem)
+		return err == nil
+	})
+
+	return src.noteConsumed(err, n)
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type EachTest struct {
+}
+
+func init() { RegisterTestSuite(&EachTest{}) }
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *EachTest) Descriptions() {
+	ExpectEq("each element: less than 17", Each(LessThan(17)).Description())
+	ExpectEq("each element: \"taco\"", Each("taco").Description())
+	ExpectEq("no element: less than 17", None(LessThan(17)).Description())
+	ExpectEq("no element: \"taco\"", None("taco").Description())
+}
+
+func (t *EachTest) WrongTypeCandidates() {
+	matchers := []Matcher{Each(""), None("")}
+
+	for _, m := range matchers {
+		var err error
+
+		// Nil candidate
+		err = m.Matches(nil)
+		ExpectTrue(isFatal(err))
+		ExpectThat(err, Error(Equals("which is not a slice, array, map, channel, iterator, or list")))
+
+		// String candidate
+		err = m.Matches("")
+		ExpectTrue(isFatal(err))
+		ExpectThat(err, Error(Equals("which is not a slice, array, map, channel, iterator, or list")))
+
+		// Pointer candidate
+		err = m.Matches(&[]string{})
+		ExpectTrue(isFatal(err))
+		ExpectThat(err, Error(Equals("which is not a slice, array, map, channel, iterator, or list")))
+	}
+}
+
+func (t *EachTest) EmptyCollections() {
+	matchers := []Matcher{Each(LessThan(17)), None(LessThan(17))}
+
+	for _, m := range matchers {
+		ExpectEq(nil, m.Matches([]int{}))
+		ExpectEq(nil, m.Matches([]int(nil)))
+		ExpectEq(nil, m.Matches([0]int{}))
+		ExpectEq(nil, m.Matches(map[string]int{}))
+	}
+}
+
+func (t *EachTest) EachSlicesAndArrays() {
+	m := Each(LessThan(17))
+
+	var c interface{}
+	var err error
+
+	// All matching slice
+	c = []int{16, -1, 0}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+
+	// All matching array
+	c = [...]float64{16.5, -1, 0}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+
+	// One non-matching element
+	c = []int{16, -1, 17, 0}
+	err = m.Matches(c)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose element 2 doesn't match")))
+
+	// Several non-matching elements; the first is reported.
+	c = [...]int{16, 19, 17}
+	err = m.Matches(c)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose element 1 doesn't match")))
+}
+
+func (t *EachTest) EachMaps() {
+	m := Each(HasSubstr("a"))
+
+	var c interface{}
+	var err error
+
+	// All matching
+	c = map[int]string{1: "taco", 2: "chalupa", 3: "enchilada"}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+
+	// Non-matching
+	c = map[string]string{"a": "taco", "b": "queso", "c": "burrito", "d": "chalupa"}
+	err = m.Matches(c)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose value for key \"b\" doesn't match")))
+}
+
+func (t *EachTest) EachPropagatesFatalErrors() {
+	m := Each(HasSubstr("a"))
+
+	err := m.Matches([]interface{}{"taco", 17, "burrito"})
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("whose element 1 doesn't match")))
+}
+
+func (t *EachTest) NoneSlicesAndArrays() {
+	m := None(17)
+
+	var c interface{}
+	var err error
+
+	// No matching elements
+	c = []int{16, -1, 0}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+
+	// One matching element
+	c = [...]float32{16, 17, 18}
+	err = m.Matches(c)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose element 1 matches")))
+}
+
+func (t *EachTest) NoneMaps() {
+	m := None(HasSubstr("q"))
+
+	var c interface{}
+	var err error
+
+	// No matching values
+	c = map[string]string{"a": "taco", "b": "burrito"}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+
+	// Matching value
+	c = map[string]string{"a": "taco", "b": "queso", "c": "quesadilla"}
+	err = m.Matches(c)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose value for key \"b\" matches")))
+}
+
+func (t *EachTest) NonePropagatesFatalErrors() {
+	m := None(HasSubstr("q"))
+
+	err := m.Matches([]interface{}{"taco", 17, "queso"})
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("whose element 1 doesn't match")))
+}
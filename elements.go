@@ -0,0 +1,245 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// A collection candidate whose elements can be visited in order. See the
+// package documentation for the kinds of collection supported.
+type elementSource struct {
+	v reflect.Value
+	l *list.List
+
+	// Whether visiting elements consumes them.
+	consumable bool
+}
+
+// Return an elementSource for the supplied candidate, or false if it isn't a
+// supported collection. Maps are supported only if allowMaps is set.
+func newElementSource(c interface{}, allowMaps bool) (*elementSource, bool) {
+	if l, ok := c.(*list.List); ok && l != nil {
+		return &elementSource{l: l}, true
+	}
+
+	v := reflect.ValueOf(c)
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		return &elementSource{v: v}, true
+
+	case reflect.Map:
+		return &elementSource{v: v}, allowMaps
+
+	case reflect.Chan:
+		if v.Type().ChanDir()&reflect.RecvDir != 0 && !v.IsNil() {
+			return &elementSource{v: v, consumable: true}, true
+		}
+
+	case reflect.Func:
+		if isIterator(v.Type()) && !v.IsNil() {
+			return &elementSource{v: v, consumable: true}, true
+		}
+	}
+
+	return nil, false
+}
+
+// Return a fatal error for a candidate that isn't a supported collection.
+func notACollection(allowMaps bool) error {
+	if allowMaps {
+		return NewFatalError("which is not a slice, array, map, channel, iterator, or list")
+	}
+
+	return NewFatalError("which is not a slice, array, channel, iterator, or list")
+}
+
+// Return true if t has the form of iter.Seq or iter.Seq2.
+func isIterator(t reflect.Type) bool {
+	if t.NumIn() != 1 || t.NumOut() != 0 || t.IsVariadic() {
+		return false
+	}
+
+	yield := t.In(0)
+	if yield.Kind() != reflect.Func || yield.IsVariadic() {
+		return false
+	}
+
+	return (yield.NumIn() == 1 || yield.NumIn() == 2) &&
+		yield.NumOut() == 1 &&
+		yield.Out(0).Kind() == reflect.Bool
+}
+
+// Return the number of elements, if it is known without visiting them.
+func (s *elementSource) length() (int, bool) {
+	if s.l != nil {
+		return s.l.Len(), true
+	}
+
+	switch s.v.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map:
+		return s.v.Len(), true
+	}
+
+	return 0, false
+}
+
+// The position of an element within a collection, for use in error text.
+type elementPos struct {
+	index int
+
+	// For maps and iter.Seq2 iterators, the key of the element.
+	key   interface{}
+	keyed bool
+}
+
+// Return a name for the element, e.g. `element 4` or `value for key "taco"`.
+func (p elementPos) name() string {
+	if p.keyed {
+		return fmt.Sprintf("value for key %s", FormatValue(p.key))
+	}
+
+	return fmt.Sprintf("element %d", p.index)
+}
+
+// Call f for each element in turn, along with its position, until f returns
+// false. Return the number of elements visited.
+//
+// Map values are visited in an order defined by their keys' printed
+// representations, so that error text is deterministic.
+func (s *elementSource) visit(f func(pos elementPos, elem interface{}) bool) (n int) {
+	if s.l != nil {
+		for e := s.l.Front(); e != nil; e = e.Next() {
+			n++
+			if !f(elementPos{index: n - 1}, e.Value) {
+				break
+			}
+		}
+
+		return
+	}
+
+	switch s.v.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < s.v.Len(); i++ {
+			n++
+			if !f(elementPos{index: i}, s.v.Index(i).Interface()) {
+				break
+			}
+		}
+
+	case reflect.Map:
+		type entry struct {
+			printedKey string
+			key        reflect.Value
+		}
+
+		entries := make([]entry, 0, s.v.Len())
+		for _, k := range s.v.MapKeys() {
+			entries = append(entries, entry{FormatValue(k.Interface()), k})
+		}
+
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].printedKey < entries[j].printedKey
+		})
+
+		for i, e := range entries {
+			n++
+			pos := elementPos{index: i, key: e.key.Interface(), keyed: true}
+			if !f(pos, s.v.MapIndex(e.key).Interface()) {
+				break
+			}
+		}
+
+	case reflect.Chan:
+		for {
+			x, ok := s.v.Recv()
+			if !ok {
+				break
+			}
+
+			n++
+			if !f(elementPos{index: n - 1}, x.Interface()) {
+				break
+			}
+		}
+
+	case reflect.Func:
+		done := false
+		yieldType := s.v.Type().In(0)
+		yield := reflect.MakeFunc(yieldType, func(args []reflect.Value) []reflect.Value {
+			// Guard against iterators that ignore a false result from yield.
+			if !done {
+				n++
+				if len(args) == 1 {
+					done = !f(elementPos{index: n - 1}, args[0].Interface())
+				} else {
+					pos := elementPos{index: n - 1, key: args[0].Interface(), keyed: true}
+					done = !f(pos, args[1].Interface())
+				}
+			}
+
+			// The result type may be a named boolean type.
+			return []reflect.Value{reflect.ValueOf(!done).Convert(yieldType.Out(0))}
+		})
+
+		s.v.Call([]reflect.Value{yield})
+	}
+
+	return
+}
+
+// Return all of the elements.
+func (s *elementSource) all() (elems []interface{}) {
+	s.visit(func(pos elementPos, elem interface{}) bool {
+		elems = append(elems, elem)
+		return true
+	})
+
+	return
+}
+
+// Return e.g. "1 element" or "2 elements".
+func pluralElements(n int) string {
+	if n == 1 {
+		return "1 element"
+	}
+
+	return fmt.Sprintf("%d elements", n)
+}
+
+// If the source is consumable, add a note to err that n elements were
+// consumed, preserving its fatality. Otherwise return err unmodified.
+func (s *elementSource) noteConsumed(err error, n int) error {
+	if err == nil || !s.consumable {
+		return err
+	}
+
+	note := "after consuming " + pluralElements(n)
+	if err.Error() != "" {
+		note = err.Error() + ", " + note
+	}
+
+	if _, isFatal := err.(*FatalError); isFatal {
+		return NewFatalError(note)
+	}
+
+	return errors.New(note)
+}
@@ -18,12 +18,11 @@ package oglematchers
 import (
 	"errors"
 	"fmt"
-	"reflect"
 	"strings"
 )
 
 // Given a list of arguments M, ElementsAre returns a matcher that matches
-// arrays and slices A where all of the following hold:
+// arrays, slices, and other collections A where all of the following hold:
 //
 //  *  A is the same length as M.
 //
@@ -33,18 +32,7 @@ import (
 //     Equals(M[i]).
 //
 func ElementsAre(M ...interface{}) Matcher {
-	// Copy over matchers, or convert to Equals(x) for non-matcher x.
-	subMatchers := make([]Matcher, len(M))
-	for i, x := range M {
-		if matcher, ok := x.(Matcher); ok {
-			subMatchers[i] = matcher
-			continue
-		}
-
-		subMatchers[i] = Equals(x)
-	}
-
-	return &elementsAreMatcher{subMatchers}
+	return &elementsAreMatcher{toMatchers(M)}
 }
 
 type elementsAreMatcher struct {
@@ -60,32 +48,54 @@ func (m *elementsAreMatcher) Description() string {
 	return fmt.Sprintf("elements are: [%s]", strings.Join(subDescs, ", "))
 }
 
+func (m *elementsAreMatcher) DescribeNegation() string {
+	return fmt.Sprintf("elements aren't: %s", describeMatchers(m.subMatchers))
+}
+
 func (m *elementsAreMatcher) Matches(candidates interface{}) error {
-	// The candidate must be a slice or an array.
-	v := reflect.ValueOf(candidates)
-	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
-		return NewFatalError("which is not a slice or array")
+	return observeMatch(nil, m, candidates)
+}
+
+func (m *elementsAreMatcher) observedMatches(
+	o *observation,
+	candidates interface{}) (err error) {
+	// The candidate must be a collection.
+	src, ok := newElementSource(candidates, false)
+	if !ok {
+		return notACollection(false)
 	}
 
-	// The length must be correct.
-	if v.Len() != len(m.subMatchers) {
-		return errors.New(fmt.Sprintf("which is of length %d", v.Len()))
+	// The length must be correct. If it can't be known in advance, we find out
+	// as we go, consuming at most one element too many.
+	if n, ok := src.length(); ok && n != len(m.subMatchers) {
+		return errors.New(fmt.Sprintf("which is of length %d", n))
 	}
 
 	// Check each element.
-	for i, subMatcher := range m.subMatchers {
-		c := v.Index(i)
-		if matchErr := subMatcher.Matches(c.Interface()); matchErr != nil {
+	n := src.visit(func(pos elementPos, elem interface{}) bool {
+		i := pos.index
+		if i >= len(m.subMatchers) {
+			err = errors.New(fmt.Sprintf("which has more than %s", pluralElements(i)))
+			return false
+		}
+
+		if matchErr := o.matches(m.subMatchers[i], elem); matchErr != nil {
 			// Return an errors indicating which element doesn't match. If the
 			// matcher error was fatal, make this one fatal too.
-			err := errors.New(fmt.Sprintf("whose element %d doesn't match", i))
+			err = errors.New(fmt.Sprintf("whose element %d doesn't match", i))
 			if _, isFatal := matchErr.(*FatalError); isFatal {
 				err = NewFatalError(err.Error())
 			}
 
-			return err
+			return false
 		}
+
+		return true
+	})
+
+	if err == nil && n != len(m.subMatchers) {
+		err = errors.New(fmt.Sprintf("which is of length %d", n))
 	}
 
-	return nil
+	return src.noteConsumed(err, n)
 }
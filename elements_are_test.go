@@ -114,7 +114,7 @@ func (t *ElementsAreTest) OneValue() {
 
 func (t *ElementsAreTest) MultipleElements() {
 	m := ElementsAre("taco", LessThan(17))
-	ExpectEq("elements are: [taco, less than 17]", m.Description())
+	ExpectEq("elements are: [\"taco\", less than 17]", m.Description())
 
 	var c []interface{}
 	var err error
@@ -0,0 +1,248 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"container/list"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type CollectionElementsTest struct {
+	// The number of elements yielded by the iterators returned by seq and
+	// seq2.
+	yielded int
+}
+
+func init() { RegisterTestSuite(&CollectionElementsTest{}) }
+
+// Return an iterator of the form of iter.Seq[int] for the supplied values.
+func (t *CollectionElementsTest) seq(vals ...int) func(func(int) bool) {
+	return func(yield func(int) bool) {
+		for _, v := range vals {
+			t.yielded++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Return an iterator of the form of iter.Seq2[string, int] for the supplied
+// keys, with values given by their lengths.
+func (t *CollectionElementsTest) seq2(keys ...string) func(func(string, int) bool) {
+	return func(yield func(string, int) bool) {
+		for _, k := range keys {
+			t.yielded++
+			if !yield(k, len(k)) {
+				return
+			}
+		}
+	}
+}
+
+// Return a closed channel containing the supplied values.
+func closedChan(vals ...int) chan int {
+	c := make(chan int, len(vals))
+	for _, v := range vals {
+		c <- v
+	}
+
+	close(c)
+	return c
+}
+
+func newList(vals ...interface{}) *list.List {
+	l := list.New()
+	for _, v := range vals {
+		l.PushBack(v)
+	}
+
+	return l
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *CollectionElementsTest) UnsupportedCandidates() {
+	var nilSeq func(func(int) bool)
+	var nilChan chan int
+
+	cases := []interface{}{
+		nilSeq,
+		nilChan,
+		make(chan<- int),
+		func(int) bool { return true },
+		func(yield func(int)) {},
+		func(yield func(int, int, int) bool) {},
+		list.List{},
+		(*list.List)(nil),
+		map[int]int{},
+	}
+
+	for _, c := range cases {
+		err := Contains(17).Matches(c)
+		ExpectTrue(isFatal(err), "%v", c)
+		ExpectThat(err, Error(Equals("which is not a slice, array, channel, iterator, or list")), "%v", c)
+	}
+}
+
+func (t *CollectionElementsTest) ContainsStopsAtFirstMatch() {
+	ExpectEq(nil, Contains(3).Matches(t.seq(1, 2, 3, 4, 5)))
+	ExpectEq(3, t.yielded)
+
+	t.yielded = 0
+	err := Contains(9).Matches(t.seq(1, 2, 3, 4, 5))
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("after consuming 5 elements")))
+	ExpectEq(5, t.yielded)
+
+	err = Contains(9).Matches(t.seq())
+	ExpectThat(err, Error(Equals("after consuming 0 elements")))
+}
+
+func (t *CollectionElementsTest) ContainsCount() {
+	err := ContainsCount(GreaterThan(2), 1).Matches(closedChan(1, 3, 5, 0))
+	ExpectThat(
+		err,
+		Error(Equals("which has 2 matching elements, at indices 1, 2, after consuming 4 elements")))
+
+	ExpectEq(nil, ContainsCount(GreaterThan(2), 2).Matches(t.seq(1, 3, 5, 0)))
+
+	// Lists aren't consumed.
+	err = ContainsCount("taco", 2).Matches(newList("taco", "burrito"))
+	ExpectThat(err, Error(Equals("which has 1 matching element, at index 0")))
+}
+
+func (t *CollectionElementsTest) EachAndNone() {
+	ExpectEq(nil, Each(GreaterThan(0)).Matches(t.seq(1, 2, 3)))
+
+	err := Each(LessThan(3)).Matches(t.seq(1, 2, 3, 4, 5))
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose element 2 doesn't match, after consuming 3 elements")))
+
+	err = Each(HasSubstr("a")).Matches(t.seq(1))
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("whose element 0 doesn't match, after consuming 1 element")))
+
+	err = None(2).Matches(closedChan(1, 2, 3))
+	ExpectThat(err, Error(Equals("whose element 1 matches, after consuming 2 elements")))
+
+	ExpectEq(nil, None("enchilada").Matches(newList("taco", "burrito")))
+}
+
+func (t *CollectionElementsTest) Seq2UsesValues() {
+	ExpectEq(nil, Each(LessThan(10)).Matches(t.seq2("taco", "burrito")))
+	ExpectEq(nil, Contains(7).Matches(t.seq2("taco", "burrito")))
+
+	t.yielded = 0
+	err := Each(LessThan(5)).Matches(t.seq2("taco", "burrito", "enchilada"))
+	ExpectThat(
+		err,
+		Error(Equals(`whose value for key "burrito" doesn't match, after consuming 2 elements`)))
+
+	ExpectEq(2, t.yielded)
+}
+
+func (t *CollectionElementsTest) ElementsAre() {
+	ExpectEq(nil, ElementsAre(1, 2, 3).Matches(t.seq(1, 2, 3)))
+	ExpectEq(nil, ElementsAre("taco", 17).Matches(newList("taco", 17)))
+	ExpectEq(nil, ElementsAre().Matches(closedChan()))
+
+	err := ElementsAre(1, 2).Matches(t.seq(1, 2, 3, 4, 5))
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has more than 2 elements, after consuming 3 elements")))
+
+	err = ElementsAre(1, 2).Matches(closedChan(1))
+	ExpectThat(err, Error(Equals("which is of length 1, after consuming 1 element")))
+
+	err = ElementsAre(1, 2).Matches(newList(1))
+	ExpectThat(err, Error(Equals("which is of length 1")))
+
+	// Mismatches are reported as soon as they're seen.
+	t.yielded = 0
+	err = ElementsAre(1, 2, 3).Matches(t.seq(1, 7, 3, 4))
+	ExpectThat(err, Error(Equals("whose element 1 doesn't match, after consuming 2 elements")))
+	ExpectEq(2, t.yielded)
+
+	err = ElementsAre(HasSubstr("a")).Matches(t.seq(1))
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("whose element 0 doesn't match, after consuming 1 element")))
+}
+
+func (t *CollectionElementsTest) SubsetAndSuperset() {
+	ExpectEq(nil, IsSupersetOf(3, 1).Matches(t.seq(1, 2, 3)))
+	ExpectEq(nil, IsSubsetOf(3, 2, 1).Matches(newList(1, 2)))
+
+	err := IsSubsetOf(1, 2).Matches(closedChan(1, 2, 3))
+	ExpectThat(err, Error(Equals("which has unexpected elements: [3], after consuming 3 elements")))
+
+	err = IsSupersetOf(4).Matches(t.seq(1, 2, 3))
+	ExpectThat(
+		err,
+		Error(Equals("which has no distinct elements matching: [4], after consuming 3 elements")))
+}
+
+func (t *CollectionElementsTest) ChannelsAreLeftWithUnconsumedElements() {
+	c := closedChan(1, 2, 3, 4)
+
+	ExpectEq(nil, Contains(2).Matches(c))
+	ExpectEq(2, len(c))
+	ExpectEq(3, <-c)
+}
+
+func (t *CollectionElementsTest) ReceiveOnlyChannels() {
+	var c <-chan int = closedChan(1, 2)
+	ExpectEq(nil, ElementsAre(1, 2).Matches(c))
+}
+
+func (t *CollectionElementsTest) IteratorsIgnoringYieldResult() {
+	// A badly behaved iterator keeps going after yield returns false. Elements
+	// after that point are ignored.
+	calls := 0
+	seq := func(yield func(int) bool) {
+		for i := 0; i < 5; i++ {
+			calls++
+			yield(i)
+		}
+	}
+
+	err := ElementsAre(0).Matches(seq)
+	ExpectThat(err, Error(Equals("which has more than 1 element, after consuming 2 elements")))
+	ExpectEq(5, calls)
+}
+
+type collectionElementsBool bool
+
+func (t *CollectionElementsTest) IteratorsWithNamedBoolYieldResult() {
+	seq := func(yield func(int) collectionElementsBool) {
+		for i := 0; i < 3; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	ExpectEq(nil, Contains(1).Matches(seq))
+	ExpectEq(nil, ElementsAre(0, 1, 2).Matches(seq))
+	ExpectEq(nil, Each(LessThan(3)).Matches(seq))
+}
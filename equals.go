@@ -16,7 +16,6 @@
 package oglematchers
 
 import (
-	"errors"
 	"fmt"
 	"math"
 	"reflect"
@@ -103,8 +102,32 @@ func isComplex(v reflect.Value) bool {
 	return k == reflect.Complex64 || k == reflect.Complex128
 }
 
+// Compare the floating point or complex value c against an expected real
+// number, given at single and double precision. The comparison is done at the
+// precision of c, to avoid a false sense of precision; otherwise e.g.
+// Equals(17.1) wouldn't match float32(17.1).
+func checkFloatingPointAgainst(e32 float32, e64 float64, c reflect.Value) error {
+	switch c.Kind() {
+	case reflect.Float32:
+		return matchIf(float32(c.Float()) == e32)
+
+	case reflect.Float64:
+		return matchIf(c.Float() == e64)
+
+	case reflect.Complex64:
+		comp := complex64(c.Complex())
+		return matchIf(imag(comp) == 0 && real(comp) == e32)
+
+	case reflect.Complex128:
+		comp := c.Complex()
+		return matchIf(imag(comp) == 0 && real(comp) == e64)
+	}
+
+	panic(fmt.Sprintf("checkFloatingPointAgainst: %v", c.Kind()))
+}
+
 func checkAgainstInt64(e int64, c reflect.Value) (err error) {
-	err = errors.New("")
+	err = errNoMatch
 
 	switch {
 	case isSignedInteger(c):
@@ -118,20 +141,19 @@ func checkAgainstInt64(e int64, c reflect.Value) (err error) {
 			err = nil
 		}
 
-	// Turn around the various floating point types so that the checkAgainst*
-	// functions for them can deal with precision issues.
+	// Compare floating point types at their own precision.
 	case isFloat(c), isComplex(c):
-		return Equals(c.Interface()).Matches(e)
+		return checkFloatingPointAgainst(float32(e), float64(e), c)
 
 	default:
-		err = NewFatalError("which is not numeric")
+		err = errNotNumeric
 	}
 
 	return
 }
 
 func checkAgainstUint64(e uint64, c reflect.Value) (err error) {
-	err = errors.New("")
+	err = errNoMatch
 
 	switch {
 	case isSignedInteger(c):
@@ -145,20 +167,19 @@ func checkAgainstUint64(e uint64, c reflect.Value) (err error) {
 			err = nil
 		}
 
-	// Turn around the various floating point types so that the checkAgainst*
-	// functions for them can deal with precision issues.
+	// Compare floating point types at their own precision.
 	case isFloat(c), isComplex(c):
-		return Equals(c.Interface()).Matches(e)
+		return checkFloatingPointAgainst(float32(e), float64(e), c)
 
 	default:
-		err = NewFatalError("which is not numeric")
+		err = errNotNumeric
 	}
 
 	return
 }
 
 func checkAgainstFloat32(e float32, c reflect.Value) (err error) {
-	err = errors.New("")
+	err = errNoMatch
 
 	switch {
 	case isSignedInteger(c):
@@ -190,14 +211,14 @@ func checkAgainstFloat32(e float32, c reflect.Value) (err error) {
 		}
 
 	default:
-		err = NewFatalError("which is not numeric")
+		err = errNotNumeric
 	}
 
 	return
 }
 
 func checkAgainstFloat64(e float64, c reflect.Value) (err error) {
-	err = errors.New("")
+	err = errNoMatch
 
 	ck := c.Kind()
 
@@ -212,11 +233,10 @@ func checkAgainstFloat64(e float64, c reflect.Value) (err error) {
 			err = nil
 		}
 
-	// If the actual value is lower precision, turn the comparison around so we
-	// apply the low-precision rules. Otherwise, e.g. Equals(0.1) may not match
-	// float32(0.1).
+	// If the actual value is lower precision, apply the low-precision rules.
+	// Otherwise, e.g. Equals(0.1) may not match float32(0.1).
 	case ck == reflect.Float32 || ck == reflect.Complex64:
-		return Equals(c.Interface()).Matches(e)
+		return checkFloatingPointAgainst(float32(e), e, c)
 
 		// Otherwise, compare with double precision.
 	case isFloat(c):
@@ -234,14 +254,14 @@ func checkAgainstFloat64(e float64, c reflect.Value) (err error) {
 		}
 
 	default:
-		err = NewFatalError("which is not numeric")
+		err = errNotNumeric
 	}
 
 	return
 }
 
 func checkAgainstComplex64(e complex64, c reflect.Value) (err error) {
-	err = errors.New("")
+	err = errNoMatch
 	realPart := real(e)
 	imaginaryPart := imag(e)
 
@@ -263,14 +283,14 @@ func checkAgainstComplex64(e complex64, c reflect.Value) (err error) {
 		}
 
 	default:
-		err = NewFatalError("which is not numeric")
+		err = errNotNumeric
 	}
 
 	return
 }
 
 func checkAgainstComplex128(e complex128, c reflect.Value) (err error) {
-	err = errors.New("")
+	err = errNoMatch
 	realPart := real(e)
 	imaginaryPart := imag(e)
 
@@ -290,7 +310,7 @@ func checkAgainstComplex128(e complex128, c reflect.Value) (err error) {
 		}
 
 	default:
-		err = NewFatalError("which is not numeric")
+		err = errNotNumeric
 	}
 
 	return
@@ -302,11 +322,11 @@ func checkAgainstComplex128(e complex128, c reflect.Value) (err error) {
 
 func checkAgainstBool(e bool, c reflect.Value) (err error) {
 	if c.Kind() != reflect.Bool {
-		err = NewFatalError("which is not a bool")
+		err = errNotBool
 		return
 	}
 
-	err = errors.New("")
+	err = errNoMatch
 	if c.Bool() == e {
 		err = nil
 	}
@@ -325,7 +345,7 @@ func checkAgainstChan(e reflect.Value, c reflect.Value) (err error) {
 		return
 	}
 
-	err = errors.New("")
+	err = errNoMatch
 	if c.Pointer() == e.Pointer() {
 		err = nil
 	}
@@ -339,7 +359,7 @@ func checkAgainstFunc(e reflect.Value, c reflect.Value) (err error) {
 		return
 	}
 
-	err = errors.New("")
+	err = errNoMatch
 	if c.Pointer() == e.Pointer() {
 		err = nil
 	}
@@ -353,7 +373,7 @@ func checkAgainstMap(e reflect.Value, c reflect.Value) (err error) {
 		return
 	}
 
-	err = errors.New("")
+	err = errNoMatch
 	if c.Pointer() == e.Pointer() {
 		err = nil
 	}
@@ -371,7 +391,7 @@ func checkAgainstPtr(e reflect.Value, c reflect.Value) (err error) {
 		return
 	}
 
-	err = errors.New("")
+	err = errNoMatch
 	if c.Pointer() == e.Pointer() {
 		err = nil
 	}
@@ -389,7 +409,7 @@ func checkAgainstSlice(e reflect.Value, c reflect.Value) (err error) {
 		return
 	}
 
-	err = errors.New("")
+	err = errNoMatch
 	if c.Pointer() == e.Pointer() {
 		err = nil
 	}
@@ -399,11 +419,11 @@ func checkAgainstSlice(e reflect.Value, c reflect.Value) (err error) {
 func checkAgainstString(e reflect.Value, c reflect.Value) (err error) {
 	// Make sure c is a string.
 	if c.Kind() != reflect.String {
-		err = NewFatalError("which is not a string")
+		err = errNotString
 		return
 	}
 
-	err = errors.New("")
+	err = errNoMatch
 	if c.String() == e.String() {
 		err = nil
 	}
@@ -422,7 +442,7 @@ func checkAgainstArray(e reflect.Value, c reflect.Value) (err error) {
 
 	// Check for equality.
 	if e.Interface() != c.Interface() {
-		err = errors.New("")
+		err = errNoMatch
 		return
 	}
 
@@ -436,7 +456,7 @@ func checkAgainstUnsafePointer(e reflect.Value, c reflect.Value) (err error) {
 		return
 	}
 
-	err = errors.New("")
+	err = errNoMatch
 	if c.Pointer() == e.Pointer() {
 		err = nil
 	}
@@ -444,7 +464,7 @@ func checkAgainstUnsafePointer(e reflect.Value, c reflect.Value) (err error) {
 }
 
 func checkForNil(c reflect.Value) (err error) {
-	err = errors.New("")
+	err = errNoMatch
 
 	// Make sure it is legal to call IsNil.
 	switch c.Kind() {
@@ -469,15 +489,59 @@ func checkForNil(c reflect.Value) (err error) {
 	return
 }
 
+// Return nil if the supplied condition holds, and errNoMatch otherwise.
+func matchIf(cond bool) error {
+	if cond {
+		return nil
+	}
+
+	return errNoMatch
+}
+
 ////////////////////////////////////////////////////////////////////////
 // Public implementation
 ////////////////////////////////////////////////////////////////////////
 
 func (m *equalsMatcher) Matches(candidate interface{}) error {
+	return observeMatch(nil, m, candidate)
+}
+
+func (m *equalsMatcher) observedMatches(
+	o *observation,
+	candidate interface{}) (err error) {
+	return m.matches(candidate)
+}
+
+func (m *equalsMatcher) matches(candidate interface{}) error {
 	e := m.expectedValue
-	c := reflect.ValueOf(candidate)
 	ek := e.Kind()
 
+	// Fast paths for the most common candidate types, for which the rules
+	// below boil down to a direct comparison.
+	switch c := candidate.(type) {
+	case int:
+		if isSignedInteger(e) {
+			return matchIf(int64(c) == e.Int())
+		}
+
+	case int64:
+		if isSignedInteger(e) {
+			return matchIf(c == e.Int())
+		}
+
+	case string:
+		if ek == reflect.String {
+			return matchIf(c == e.String())
+		}
+
+	case bool:
+		if ek == reflect.Bool {
+			return matchIf(c == e.Bool())
+		}
+	}
+
+	c := reflect.ValueOf(candidate)
+
 	switch {
 	case ek == reflect.Bool:
 		return checkAgainstBool(e.Bool(), c)
@@ -537,5 +601,14 @@ func (m *equalsMatcher) Description() string {
 		return "is nil"
 	}
 
-	return fmt.Sprintf("%v", m.expectedValue.Interface())
+	return FormatValue(m.expectedValue.Interface())
+}
+
+func (m *equalsMatcher) DescribeNegation() string {
+	// Special case: handle nil.
+	if !m.expectedValue.IsValid() {
+		return "is not nil"
+	}
+
+	return fmt.Sprintf("is not equal to %s", FormatValue(m.expectedValue.Interface()))
 }
@@ -0,0 +1,118 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// EqualsSemantic returns a matcher like Equals(x) that honors Equal methods.
+// A candidate c whose type has a method of the form Equal(U) bool, where x is
+// assignable to U, matches if c.Equal(x) returns true. For example:
+//
+//     t := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+//     ExpectThat(t.In(time.Local), EqualsSemantic(t))  // Passes
+//
+// Unlike Equals, structs are supported. A struct or array candidate with the
+// same type as x is compared field by field or element by element as if with
+// ==, except that Equal methods are honored at any level of nesting. Other
+// candidates are compared as with Equals(x).
+//
+// For the strict behavior, which ignores Equal methods, use Equals or
+// DeepEqualsWith(x, StrictEquality()).
+func EqualsSemantic(x interface{}) Matcher {
+	m := &equalsSemanticMatcher{x: x}
+
+	v := reflect.ValueOf(x)
+	switch v.Kind() {
+	case reflect.Struct, reflect.Array:
+		if _, ok := equalMethodArg(v.Type()); !ok && !v.Type().Comparable() {
+			panic(fmt.Sprintf("EqualsSemantic: %v is not comparable", v.Type()))
+		}
+
+	default:
+		m.fallback = Equals(x)
+	}
+
+	return m
+}
+
+type equalsSemanticMatcher struct {
+	x interface{}
+
+	// The matcher used for candidates not handled specially, if any.
+	fallback Matcher
+}
+
+func (m *equalsSemanticMatcher) Description() string {
+	if m.x == nil {
+		return "is nil"
+	}
+
+	return fmt.Sprintf("semantically equal to %s", FormatValue(m.x))
+}
+
+func (m *equalsSemanticMatcher) DescribeNegation() string {
+	if m.x == nil {
+		return "is not nil"
+	}
+
+	return fmt.Sprintf("not semantically equal to %s", FormatValue(m.x))
+}
+
+func (m *equalsSemanticMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *equalsSemanticMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	cv := reflect.ValueOf(c)
+	xv := reflect.ValueOf(m.x)
+
+	// Prefer the candidate's Equal method, if it accepts x. Nil candidates are
+	// left to the rules below, since the method may not expect them.
+	if cv.IsValid() && xv.IsValid() && !(isNillable(cv.Kind()) && cv.IsNil()) {
+		if u, ok := equalMethodArg(cv.Type()); ok && xv.Type().AssignableTo(u) {
+			if cv.MethodByName("Equal").Call([]reflect.Value{xv})[0].Bool() {
+				return nil
+			}
+
+			return errNoMatch
+		}
+	}
+
+	// Compare composite values of the same type piece by piece.
+	if cv.IsValid() && xv.IsValid() && cv.Type() == xv.Type() {
+		switch cv.Kind() {
+		case reflect.Struct, reflect.Array:
+			// Like ==, this compares unexported fields.
+			cmp := deepComparison{opts: &deepEqualsDefaults, shallow: true}
+			if cmp.equal(cv, xv, "") {
+				return nil
+			}
+
+			return cmp.err()
+		}
+	}
+
+	if m.fallback == nil {
+		return NewFatalError(fmt.Sprintf("which is of type %v", reflect.TypeOf(c)))
+	}
+
+	return o.matches(m.fallback, c)
+}
@@ -0,0 +1,152 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"time"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type EqualsSemanticTest struct {
+}
+
+func init() { RegisterTestSuite(&EqualsSemanticTest{}) }
+
+// A type whose Equal method accepts any fmt.Stringer-like value with the
+// same name.
+type equalsSemanticNamed struct {
+	Name string
+}
+
+func (n equalsSemanticNamed) String() string { return n.Name }
+
+func (n equalsSemanticNamed) Equal(o interface{ String() string }) bool {
+	return n.Name == o.String()
+}
+
+type equalsSemanticOtherNamed string
+
+func (n equalsSemanticOtherNamed) String() string { return string(n) }
+
+type equalsSemanticRecord struct {
+	ID   int
+	When time.Time
+}
+
+type equalsSemanticUncomparable struct {
+	Tags []string
+}
+
+var equalsSemanticWhen = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *EqualsSemanticTest) Descriptions() {
+	m := EqualsSemantic(17)
+	ExpectEq("semantically equal to 17", m.Description())
+	ExpectEq("not semantically equal to 17", Not(m).Description())
+
+	m = EqualsSemantic(nil)
+	ExpectEq("is nil", m.Description())
+	ExpectEq("is not nil", Not(m).Description())
+}
+
+func (t *EqualsSemanticTest) UncomparableStructPanics() {
+	ExpectThat(
+		func() { EqualsSemantic(equalsSemanticUncomparable{}) },
+		Panics(HasSubstr("equalsSemanticUncomparable is not comparable")))
+}
+
+func (t *EqualsSemanticTest) EqualMethod() {
+	m := EqualsSemantic(equalsSemanticWhen)
+
+	ExpectEq(nil, m.Matches(equalsSemanticWhen.In(time.FixedZone("x", 3600))))
+
+	err := m.Matches(equalsSemanticWhen.Add(1))
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("")))
+
+	// Equals doesn't support structs at all.
+	ExpectThat(func() { Equals(equalsSemanticWhen) }, Panics(HasSubstr("unsupported kind")))
+}
+
+func (t *EqualsSemanticTest) EqualMethodWithOtherArgumentType() {
+	m := EqualsSemantic(equalsSemanticOtherNamed("taco"))
+
+	ExpectEq(nil, m.Matches(equalsSemanticNamed{"taco"}))
+	ExpectThat(m.Matches(equalsSemanticNamed{"burrito"}), Error(Equals("")))
+}
+
+func (t *EqualsSemanticTest) EqualMethodWhenNested() {
+	m := EqualsSemantic(equalsSemanticRecord{17, equalsSemanticWhen})
+
+	ExpectEq(nil, m.Matches(equalsSemanticRecord{17, equalsSemanticWhen.Local()}))
+
+	err := m.Matches(equalsSemanticRecord{18, equalsSemanticWhen})
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose ID is 18 rather than 17")))
+
+	err = m.Matches(equalsSemanticRecord{17, equalsSemanticWhen.Add(1)})
+	ExpectThat(err, Error(HasSubstr("whose When is")))
+
+	// Arrays work the same way.
+	a := [2]time.Time{equalsSemanticWhen, equalsSemanticWhen}
+	b := [2]time.Time{equalsSemanticWhen.Local(), equalsSemanticWhen.Local()}
+	ExpectEq(nil, EqualsSemantic(a).Matches(b))
+}
+
+func (t *EqualsSemanticTest) PointersAreComparedByIdentity() {
+	type node struct {
+		Next *node
+	}
+
+	n := &node{}
+	ExpectEq(nil, EqualsSemantic(node{n}).Matches(node{n}))
+	ExpectThat(EqualsSemantic(node{n}).Matches(node{&node{}}), Error(HasSubstr("whose Next is")))
+}
+
+func (t *EqualsSemanticTest) WrongTypeStructCandidate() {
+	m := EqualsSemantic(equalsSemanticRecord{})
+
+	err := m.Matches(17)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is of type int")))
+
+	err = m.Matches(nil)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is of type <nil>")))
+}
+
+func (t *EqualsSemanticTest) FallsBackToEquals() {
+	m := EqualsSemantic(17)
+	ExpectEq(nil, m.Matches(17))
+	ExpectEq(nil, m.Matches(17.0))
+	ExpectThat(m.Matches(18), Error(Equals("")))
+	ExpectTrue(isFatal(m.Matches("taco")))
+
+	// Nil pointers are never passed to Equal methods.
+	var p *time.Time
+	ExpectEq(nil, EqualsSemantic(p).Matches(p))
+	ExpectEq(nil, EqualsSemantic(nil).Matches(nil))
+}
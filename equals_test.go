@@ -18,6 +18,8 @@ package oglematchers_test
 import (
 	"fmt"
 	"math"
+	"os"
+	"testing"
 	"unsafe"
 
 	. "github.com/jacobsa/oglematchers"
@@ -3457,7 +3459,7 @@ func (t *EqualsTest) NilMap() {
 	var nonNilMap2 map[int]uint = make(map[int]uint)
 
 	matcher := Equals(nilMap1)
-	ExpectEq("map[]", matcher.Description())
+	ExpectEq("<nil map>", matcher.Description())
 
 	cases := []equalsTestCase{
 		// Correct type.
@@ -3599,7 +3601,7 @@ func (t *EqualsTest) NonNilPointer() {
 	var nonNilUint *uint = &someUint
 
 	matcher := Equals(nonNilInt1)
-	ExpectEq(fmt.Sprintf("%v", nonNilInt1), matcher.Description())
+	ExpectEq(fmt.Sprintf("%v -> 17", nonNilInt1), matcher.Description())
 
 	cases := []equalsTestCase{
 		// Correct type.
@@ -3649,7 +3651,7 @@ func (t *EqualsTest) NilSlice() {
 	var nonNilUint []uint = make([]uint, 0)
 
 	matcher := Equals(nilInt1)
-	ExpectEq("[]", matcher.Description())
+	ExpectEq("<nil slice>", matcher.Description())
 
 	cases := []equalsTestCase{
 		// Correct type.
@@ -3700,7 +3702,7 @@ func (t *EqualsTest) String() {
 	expected := fmt.Sprintf("%s%d", partial, 1)
 
 	matcher := Equals(expected)
-	ExpectEq("taco1", matcher.Description())
+	ExpectEq("\"taco1\"", matcher.Description())
 
 	type stringAlias string
 
@@ -3744,7 +3746,7 @@ func (t *EqualsTest) StringAlias() {
 	type stringAlias string
 
 	matcher := Equals(stringAlias("taco"))
-	ExpectEq("taco", matcher.Description())
+	ExpectEq("\"taco\"", matcher.Description())
 
 	cases := []equalsTestCase{
 		// Correct types.
@@ -3862,3 +3864,64 @@ func (t *EqualsTest) NonNilUnsafePointer() {
 
 	t.checkTestCases(matcher, cases)
 }
+
+////////////////////////////////////////////////////////////////////////
+// Allocations
+////////////////////////////////////////////////////////////////////////
+
+func (t *EqualsTest) MatchingDoesntAllocate() {
+	// Observing matchers, as tracing does, necessarily allocates.
+	if os.Getenv("OGLEMATCHERS_TRACE") != "" {
+		return
+	}
+
+	cases := []struct {
+		matcher   Matcher
+		candidate interface{}
+	}{
+		{Equals(17), 17},
+		{Equals(17), 19},
+		{Equals(17), int64(17)},
+		{Equals(17), uint8(17)},
+		{Equals(17.5), 17.5},
+		{Equals("taco"), "taco"},
+		{Equals("taco"), "burrito"},
+		{Equals(true), true},
+		{Equals(nil), nil},
+	}
+
+	for _, c := range cases {
+		allocs := testing.AllocsPerRun(100, func() { c.matcher.Matches(c.candidate) })
+		ExpectEq(0, allocs, "%s, %v", c.matcher.Description(), c.candidate)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////
+// Benchmarks
+////////////////////////////////////////////////////////////////////////
+
+func benchmarkEquals(b *testing.B, x interface{}, c interface{}) {
+	matcher := Equals(x)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		matcher.Matches(c)
+	}
+}
+
+func BenchmarkEqualsInt(b *testing.B) {
+	benchmarkEquals(b, 17, 17)
+}
+
+func BenchmarkEqualsIntMismatch(b *testing.B) {
+	benchmarkEquals(b, 17, 19)
+}
+
+func BenchmarkEqualsMixedIntegers(b *testing.B) {
+	benchmarkEquals(b, 17, uint32(17))
+}
+
+func BenchmarkEqualsString(b *testing.B) {
+	benchmarkEquals(b, "taco", "taco")
+}
@@ -39,7 +39,17 @@ func (m *errorMatcher) Description() string {
 	return "error " + m.wrappedMatcher.Description()
 }
 
+func (m *errorMatcher) DescribeNegation() string {
+	return "error " + describeNegation(m.wrappedMatcher)
+}
+
 func (m *errorMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *errorMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
 	// Make sure that c is an error.
 	e, ok := c.(error)
 	if !ok {
@@ -47,5 +57,5 @@ func (m *errorMatcher) Matches(c interface{}) error {
 	}
 
 	// Pass on the error text to the wrapped matcher.
-	return m.wrappedMatcher.Matches(e.Error())
+	return o.matches(m.wrappedMatcher, e.Error())
 }
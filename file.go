@@ -0,0 +1,275 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+)
+
+// The matchers in this file take file system paths as candidates, and follow
+// symbolic links. Candidates that are not strings result in fatal errors, as
+// do errors from the operating system other than those indicating that a file
+// doesn't exist for FileExists.
+
+// FileExists returns a matcher that matches paths naming an existing file or
+// directory.
+func FileExists() Matcher {
+	return &fileExistsMatcher{}
+}
+
+// IsDir returns a matcher that matches paths naming an existing directory.
+func IsDir() Matcher {
+	return &isDirMatcher{}
+}
+
+// FileMode returns a matcher that matches paths naming an existing file whose
+// os.FileMode matches m. If m is not a Matcher, it is treated as Equals(m).
+// Note that the mode includes type bits such as os.ModeDir in addition to
+// permissions.
+func FileMode(m interface{}) Matcher {
+	return newFileInfoMatcher(
+		"FileMode",
+		"mode",
+		func(fi os.FileInfo) interface{} { return fi.Mode() },
+		m)
+}
+
+// FileSize returns a matcher that matches paths naming an existing file whose
+// size in bytes, as an int64, matches m. If m is not a Matcher, it is treated
+// as Equals(m).
+func FileSize(m interface{}) Matcher {
+	return newFileInfoMatcher(
+		"FileSize",
+		"size",
+		func(fi os.FileInfo) interface{} { return fi.Size() },
+		m)
+}
+
+// FileContents returns a matcher that matches paths naming an existing file
+// whose contents match m. If m is not a Matcher, it is treated as Equals(m).
+// As with ReaderContents, the contents are given to m as a string, and then
+// as a []byte if that results in a fatal error.
+func FileContents(m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &fileContentsMatcher{wrapped}
+}
+
+// Extract the path from a candidate, or return a fatal error.
+func candidatePath(c interface{}) (string, error) {
+	v := reflect.ValueOf(c)
+	if v.Kind() != reflect.String {
+		return "", NewFatalError("which is not a path string")
+	}
+
+	return v.String(), nil
+}
+
+func statCandidate(c interface{}) (os.FileInfo, error) {
+	path, err := candidatePath(c)
+	if err != nil {
+		return nil, err
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, NewFatalError(fmt.Sprintf("which couldn't be examined: %v", err))
+	}
+
+	return fi, nil
+}
+
+////////////////////////////////////////////////////////////////////////
+// FileExists
+////////////////////////////////////////////////////////////////////////
+
+type fileExistsMatcher struct {
+}
+
+func (m *fileExistsMatcher) Description() string {
+	return "names an existing file"
+}
+
+func (m *fileExistsMatcher) DescribeNegation() string {
+	return "doesn't name an existing file"
+}
+
+func (m *fileExistsMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *fileExistsMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	path, err := candidatePath(c)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stat(path)
+	switch {
+	case os.IsNotExist(err):
+		return errors.New("which doesn't exist")
+
+	case err != nil:
+		return NewFatalError(fmt.Sprintf("which couldn't be examined: %v", err))
+	}
+
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////
+// IsDir
+////////////////////////////////////////////////////////////////////////
+
+type isDirMatcher struct {
+}
+
+func (m *isDirMatcher) Description() string {
+	return "names a directory"
+}
+
+func (m *isDirMatcher) DescribeNegation() string {
+	return "doesn't name a directory"
+}
+
+func (m *isDirMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *isDirMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	fi, err := statCandidate(c)
+	if err != nil {
+		return err
+	}
+
+	if !fi.IsDir() {
+		return errors.New(fmt.Sprintf("which has mode %v", fi.Mode()))
+	}
+
+	return nil
+}
+
+////////////////////////////////////////////////////////////////////////
+// FileMode and FileSize
+////////////////////////////////////////////////////////////////////////
+
+func newFileInfoMatcher(
+	name string,
+	noun string,
+	get func(os.FileInfo) interface{},
+	m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &fileInfoMatcher{name, noun, get, wrapped}
+}
+
+type fileInfoMatcher struct {
+	name    string
+	noun    string
+	get     func(os.FileInfo) interface{}
+	wrapped Matcher
+}
+
+func (m *fileInfoMatcher) spec() (string, []interface{}) {
+	return m.name, []interface{}{m.wrapped}
+}
+
+func (m *fileInfoMatcher) Description() string {
+	return fmt.Sprintf("whose file %s matches: %s", m.noun, m.wrapped.Description())
+}
+
+func (m *fileInfoMatcher) DescribeNegation() string {
+	return fmt.Sprintf("whose file %s doesn't match: %s", m.noun, m.wrapped.Description())
+}
+
+func (m *fileInfoMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *fileInfoMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	fi, err := statCandidate(c)
+	if err != nil {
+		return err
+	}
+
+	x := m.get(fi)
+	err = o.matches(m.wrapped, x)
+	if err == nil {
+		return nil
+	}
+
+	wrappedClause := ""
+	if err.Error() != "" {
+		wrappedClause = ", " + err.Error()
+	}
+
+	s := fmt.Sprintf("whose file %s is %s%s", m.noun, FormatValue(x), wrappedClause)
+	if _, isFatal := err.(*FatalError); isFatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
+
+////////////////////////////////////////////////////////////////////////
+// FileContents
+////////////////////////////////////////////////////////////////////////
+
+type fileContentsMatcher struct {
+	wrapped Matcher
+}
+
+func (m *fileContentsMatcher) Description() string {
+	return fmt.Sprintf("whose file contents match: %s", m.wrapped.Description())
+}
+
+func (m *fileContentsMatcher) DescribeNegation() string {
+	return fmt.Sprintf("whose file contents don't match: %s", m.wrapped.Description())
+}
+
+func (m *fileContentsMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *fileContentsMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	path, err := candidatePath(c)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return NewFatalError(fmt.Sprintf("which couldn't be read: %v", err))
+	}
+
+	return matchContents(o, m.wrapped, data)
+}
@@ -0,0 +1,162 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type FileTest struct {
+	dir     string
+	file    string
+	missing string
+}
+
+func init() { RegisterTestSuite(&FileTest{}) }
+
+func (t *FileTest) SetUp(i *TestInfo) {
+	var err error
+	t.dir, err = os.MkdirTemp("", "file_test")
+	AssertEq(nil, err)
+
+	t.file = filepath.Join(t.dir, "foo")
+	AssertEq(nil, os.WriteFile(t.file, []byte("taco burrito"), 0640))
+	AssertEq(nil, os.Chmod(t.file, 0640))
+
+	t.missing = filepath.Join(t.dir, "bar")
+}
+
+func (t *FileTest) TearDown() {
+	os.RemoveAll(t.dir)
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *FileTest) Descriptions() {
+	ExpectEq("names an existing file", FileExists().Description())
+	ExpectEq("doesn't name an existing file", Not(FileExists()).Description())
+	ExpectEq("names a directory", IsDir().Description())
+	ExpectEq("doesn't name a directory", Not(IsDir()).Description())
+	ExpectEq("whose file mode matches: 420", FileMode(0644).Description())
+	ExpectEq("whose file size matches: less than 17", FileSize(LessThan(17)).Description())
+	ExpectEq("whose file contents match: \"taco\"", FileContents("taco").Description())
+	ExpectEq(
+		"whose file contents don't match: \"taco\"",
+		Not(FileContents("taco")).Description())
+}
+
+func (t *FileTest) WrongTypeCandidates() {
+	matchers := []Matcher{
+		FileExists(),
+		IsDir(),
+		FileMode(0644),
+		FileSize(17),
+		FileContents(""),
+	}
+
+	for _, m := range matchers {
+		var err error
+
+		err = m.Matches(nil)
+		ExpectTrue(isFatal(err))
+		ExpectThat(err, Error(Equals("which is not a path string")))
+
+		err = m.Matches(17)
+		ExpectTrue(isFatal(err))
+		ExpectThat(err, Error(Equals("which is not a path string")))
+	}
+}
+
+func (t *FileTest) FileExists() {
+	m := FileExists()
+
+	ExpectEq(nil, m.Matches(t.file))
+	ExpectEq(nil, m.Matches(t.dir))
+
+	err := m.Matches(t.missing)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which doesn't exist")))
+
+	ExpectEq(nil, Not(m).Matches(t.missing))
+}
+
+func (t *FileTest) IsDir() {
+	m := IsDir()
+
+	ExpectEq(nil, m.Matches(t.dir))
+
+	err := m.Matches(t.file)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has mode -rw-r-----")))
+
+	err = m.Matches(t.missing)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which couldn't be examined: stat "+t.missing)))
+}
+
+func (t *FileTest) FileMode() {
+	ExpectEq(nil, FileMode(0640).Matches(t.file))
+	ExpectEq(nil, FileMode(os.FileMode(0640)).Matches(t.file))
+
+	err := FileMode(0644).Matches(t.file)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose file mode is -rw-r-----")))
+
+	err = FileMode(0644).Matches(t.missing)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which couldn't be examined")))
+}
+
+func (t *FileTest) FileSize() {
+	ExpectEq(nil, FileSize(12).Matches(t.file))
+	ExpectEq(nil, FileSize(GreaterThan(10)).Matches(t.file))
+
+	err := FileSize(LessThan(10)).Matches(t.file)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose file size is 12")))
+
+	err = FileSize(HasSubstr("1")).Matches(t.file)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("whose file size is 12, which is not a string")))
+}
+
+func (t *FileTest) FileContents() {
+	ExpectEq(nil, FileContents("taco burrito").Matches(t.file))
+	ExpectEq(nil, FileContents(HasSubstr("burr")).Matches(t.file))
+	ExpectEq(nil, FileContents(DeepEquals([]byte("taco burrito"))).Matches(t.file))
+
+	err := FileContents(HasSubstr("queso")).Matches(t.file)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose contents are \"taco burrito\"")))
+
+	err = FileContents("").Matches(t.missing)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which couldn't be read: open "+t.missing)))
+
+	err = FileContents("").Matches(t.dir)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which couldn't be read")))
+}
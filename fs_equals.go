@@ -0,0 +1,282 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// An FSOption modifies the behavior of FSEquals and FSContains.
+type FSOption func(*fsOptions)
+
+type fsOptions struct {
+	compareModes bool
+}
+
+// CompareFileModes causes FSEquals and FSContains to also require that the
+// permission bits of corresponding files agree. Note that the files of an
+// fstest.MapFS have no permission bits unless set explicitly.
+func CompareFileModes() FSOption {
+	return func(o *fsOptions) { o.compareModes = true }
+}
+
+// FSEquals returns a matcher that matches file trees containing exactly the
+// same files as expected, with the same contents. Candidates may be an fs.FS
+// (for example an fstest.MapFS) or the path of a directory. Only regular files
+// are compared; directories are considered only in that they contain files.
+//
+// When the trees differ, the error lists the missing, extra, and differing
+// files, followed by a unified diff for each text file with differing
+// contents. Errors reading either tree are reported as fatal errors.
+//
+// The list of files in expected is read once, when the matcher is created;
+// their contents are read each time a candidate is compared.
+func FSEquals(expected fs.FS, opts ...FSOption) Matcher {
+	return newFSMatcher(expected, false, opts)
+}
+
+// FSContains is like FSEquals, except that the candidate may contain files in
+// addition to those in expected.
+func FSContains(expected fs.FS, opts ...FSOption) Matcher {
+	return newFSMatcher(expected, true, opts)
+}
+
+func newFSMatcher(expected fs.FS, allowExtra bool, opts []FSOption) Matcher {
+	m := &fsMatcher{expected: expected, allowExtra: allowExtra}
+	for _, o := range opts {
+		o(&m.opts)
+	}
+
+	m.expectedNames, m.expectedErr = listFiles(expected)
+
+	return m
+}
+
+type fsMatcher struct {
+	expected   fs.FS
+	allowExtra bool
+	opts       fsOptions
+
+	// The result of listing the files in expected.
+	expectedNames []string
+	expectedErr   error
+}
+
+func (m *fsMatcher) describe(equalsVerb, containsVerb string) string {
+	verb := equalsVerb
+	if m.allowExtra {
+		verb = containsVerb
+	}
+
+	if m.expectedErr != nil {
+		return fmt.Sprintf("file tree %s: <unreadable: %v>", verb, m.expectedErr)
+	}
+
+	return fmt.Sprintf("file tree %s: [%s]", verb, strings.Join(m.expectedNames, ", "))
+}
+
+func (m *fsMatcher) Description() string {
+	return m.describe("equals", "contains")
+}
+
+func (m *fsMatcher) DescribeNegation() string {
+	return m.describe("doesn't equal", "doesn't contain")
+}
+
+// Return the sorted paths of the regular files in fsys.
+func listFiles(fsys fs.FS) ([]string, error) {
+	var names []string
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.Type().IsRegular() {
+			names = append(names, p)
+		}
+
+		return nil
+	})
+
+	sort.Strings(names)
+	return names, err
+}
+
+// Convert a candidate to an fs.FS, or return a fatal error.
+func candidateFS(c interface{}) (fs.FS, error) {
+	if fsys, ok := c.(fs.FS); ok {
+		return fsys, nil
+	}
+
+	v := reflect.ValueOf(c)
+	if v.Kind() != reflect.String {
+		return nil, NewFatalError("which is not an fs.FS or a directory path")
+	}
+
+	fi, err := os.Stat(v.String())
+	if err != nil {
+		return nil, NewFatalError(fmt.Sprintf("which couldn't be examined: %v", err))
+	}
+
+	if !fi.IsDir() {
+		return nil, NewFatalError("which is not a directory")
+	}
+
+	return os.DirFS(v.String()), nil
+}
+
+func (m *fsMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *fsMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	actual, err := candidateFS(c)
+	if err != nil {
+		return err
+	}
+
+	expectedNames := m.expectedNames
+	if m.expectedErr != nil {
+		return NewFatalError(fmt.Sprintf("which couldn't be compared: expected tree: %v", m.expectedErr))
+	}
+
+	actualNames, err := listFiles(actual)
+	if err != nil {
+		return NewFatalError(fmt.Sprintf("which couldn't be read: %v", err))
+	}
+
+	actualSet := make(map[string]bool)
+	for _, name := range actualNames {
+		actualSet[name] = true
+	}
+
+	expectedSet := make(map[string]bool)
+	for _, name := range expectedNames {
+		expectedSet[name] = true
+	}
+
+	var summary []string
+	var diffs []string
+
+	for _, name := range expectedNames {
+		if !actualSet[name] {
+			summary = append(summary, "missing: "+name)
+			continue
+		}
+
+		problem, diff, err := m.compareFile(actual, name)
+		if err != nil {
+			return NewFatalError(fmt.Sprintf("which couldn't be compared: %v", err))
+		}
+
+		if problem != "" {
+			summary = append(summary, problem)
+		}
+
+		if diff != "" {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	if !m.allowExtra {
+		for _, name := range actualNames {
+			if !expectedSet[name] {
+				summary = append(summary, "extra: "+name)
+			}
+		}
+	}
+
+	if len(summary) == 0 {
+		return nil
+	}
+
+	s := "which differs from the expected tree:\n  " + strings.Join(summary, "\n  ")
+	if len(diffs) > 0 {
+		s += "\n" + strings.Join(diffs, "")
+	}
+
+	return errors.New(s)
+}
+
+// Compare the file with the supplied name in the expected and actual trees,
+// returning a summary line and a diff if they differ.
+func (m *fsMatcher) compareFile(
+	actual fs.FS,
+	name string) (problem string, diff string, err error) {
+	expectedData, err := fs.ReadFile(m.expected, name)
+	if err != nil {
+		return
+	}
+
+	actualData, err := fs.ReadFile(actual, name)
+	if err != nil {
+		return
+	}
+
+	var problems []string
+
+	if m.opts.compareModes {
+		var expectedInfo, actualInfo fs.FileInfo
+		if expectedInfo, err = fs.Stat(m.expected, name); err != nil {
+			return
+		}
+
+		if actualInfo, err = fs.Stat(actual, name); err != nil {
+			return
+		}
+
+		if expectedInfo.Mode().Perm() != actualInfo.Mode().Perm() {
+			problems = append(problems, fmt.Sprintf(
+				"mode %v, expected %v",
+				actualInfo.Mode().Perm(),
+				expectedInfo.Mode().Perm()))
+		}
+	}
+
+	if string(expectedData) != string(actualData) {
+		if isText(expectedData) && isText(actualData) {
+			problems = append(problems, "contents")
+			diff = unifiedDiff(
+				path.Join("expected", name),
+				path.Join("candidate", name),
+				string(expectedData),
+				string(actualData))
+		} else {
+			problems = append(problems, "binary contents")
+		}
+	}
+
+	if len(problems) > 0 {
+		problem = fmt.Sprintf("differs: %s (%s)", name, strings.Join(problems, ", "))
+	}
+
+	return
+}
+
+// Return true if the data looks like text, and is therefore worth diffing.
+func isText(data []byte) bool {
+	return utf8.Valid(data) && !strings.ContainsRune(string(data), 0)
+}
@@ -0,0 +1,223 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing/fstest"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type FSEqualsTest struct {
+	dir string
+}
+
+func init() { RegisterTestSuite(&FSEqualsTest{}) }
+
+func (t *FSEqualsTest) SetUp(i *TestInfo) {
+	var err error
+	t.dir, err = os.MkdirTemp("", "fs_equals_test")
+	AssertEq(nil, err)
+}
+
+func (t *FSEqualsTest) TearDown() {
+	os.RemoveAll(t.dir)
+}
+
+func (t *FSEqualsTest) writeFile(name string, contents string, mode os.FileMode) {
+	p := filepath.Join(t.dir, filepath.FromSlash(name))
+	AssertEq(nil, os.MkdirAll(filepath.Dir(p), 0755))
+	AssertEq(nil, os.WriteFile(p, []byte(contents), mode))
+	AssertEq(nil, os.Chmod(p, mode))
+}
+
+func fsFile(contents string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(contents)}
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *FSEqualsTest) Descriptions() {
+	expected := fstest.MapFS{
+		"b/c.txt": fsFile(""),
+		"a.txt":   fsFile(""),
+	}
+
+	ExpectEq("file tree equals: [a.txt, b/c.txt]", FSEquals(expected).Description())
+	ExpectEq("file tree doesn't equal: [a.txt, b/c.txt]", Not(FSEquals(expected)).Description())
+	ExpectEq("file tree contains: [a.txt, b/c.txt]", FSContains(expected).Description())
+	ExpectEq("file tree doesn't contain: [a.txt, b/c.txt]", Not(FSContains(expected)).Description())
+}
+
+func (t *FSEqualsTest) ExpectedTreeIsListedOnce() {
+	t.writeFile("a.txt", "taco\n", 0644)
+	m := FSEquals(os.DirFS(t.dir))
+
+	t.writeFile("b.txt", "burrito\n", 0644)
+	ExpectEq("file tree equals: [a.txt]", m.Description())
+	ExpectEq(nil, m.Matches(fstest.MapFS{"a.txt": fsFile("taco\n")}))
+}
+
+func (t *FSEqualsTest) IrregularFilesAreIgnored() {
+	t.writeFile("a.txt", "taco\n", 0644)
+	AssertEq(nil, os.Symlink("a.txt", filepath.Join(t.dir, "link")))
+
+	expected := fstest.MapFS{"a.txt": fsFile("taco\n")}
+	ExpectEq(nil, FSEquals(expected).Matches(t.dir))
+	ExpectEq("file tree equals: [a.txt]", FSEquals(os.DirFS(t.dir)).Description())
+}
+
+func (t *FSEqualsTest) WrongTypeCandidates() {
+	m := FSEquals(fstest.MapFS{})
+
+	var err error
+
+	err = m.Matches(nil)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is not an fs.FS or a directory path")))
+
+	err = m.Matches(17)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is not an fs.FS or a directory path")))
+
+	err = m.Matches(filepath.Join(t.dir, "missing"))
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which couldn't be examined")))
+
+	t.writeFile("foo", "", 0644)
+	err = m.Matches(filepath.Join(t.dir, "foo"))
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is not a directory")))
+}
+
+func (t *FSEqualsTest) MapFSOnBothSides() {
+	expected := fstest.MapFS{
+		"a.txt":   fsFile("taco\n"),
+		"b/c.txt": fsFile("burrito\n"),
+	}
+
+	ExpectEq(nil, FSEquals(expected).Matches(fstest.MapFS{
+		"a.txt":   fsFile("taco\n"),
+		"b/c.txt": fsFile("burrito\n"),
+	}))
+
+	// Empty directories are ignored.
+	ExpectEq(nil, FSEquals(expected).Matches(fstest.MapFS{
+		"a.txt":   fsFile("taco\n"),
+		"b/c.txt": fsFile("burrito\n"),
+		"d":       &fstest.MapFile{Mode: os.ModeDir},
+	}))
+}
+
+func (t *FSEqualsTest) DirectoryCandidate() {
+	t.writeFile("a.txt", "taco\n", 0644)
+	t.writeFile("b/c.txt", "burrito\n", 0644)
+
+	expected := fstest.MapFS{
+		"a.txt":   fsFile("taco\n"),
+		"b/c.txt": fsFile("burrito\n"),
+	}
+
+	ExpectEq(nil, FSEquals(expected).Matches(t.dir))
+	ExpectEq(nil, FSEquals(os.DirFS(t.dir)).Matches(expected))
+}
+
+func (t *FSEqualsTest) Differences() {
+	expected := fstest.MapFS{
+		"a.txt":     fsFile("taco\nburrito\n"),
+		"b/c.txt":   fsFile("queso\n"),
+		"d.txt":     fsFile("same\n"),
+		"image.png": fsFile("\x89PNG\x00"),
+	}
+
+	actual := fstest.MapFS{
+		"a.txt":     fsFile("taco\nenchilada\n"),
+		"d.txt":     fsFile("same\n"),
+		"e.txt":     fsFile("extra\n"),
+		"image.png": fsFile("\x89PNG\x01"),
+	}
+
+	err := FSEquals(expected).Matches(actual)
+	ExpectFalse(isFatal(err))
+	ExpectThat(
+		err,
+		Error(Equals(
+			"which differs from the expected tree:\n"+
+				"  differs: a.txt (contents)\n"+
+				"  missing: b/c.txt\n"+
+				"  differs: image.png (binary contents)\n"+
+				"  extra: e.txt\n"+
+				"--- expected/a.txt\n"+
+				"+++ candidate/a.txt\n"+
+				"@@ -1,2 +1,2 @@\n"+
+				" taco\n"+
+				"-burrito\n"+
+				"+enchilada\n")))
+}
+
+func (t *FSEqualsTest) Contains() {
+	expected := fstest.MapFS{
+		"a.txt": fsFile("taco\n"),
+	}
+
+	ExpectEq(nil, FSContains(expected).Matches(fstest.MapFS{
+		"a.txt": fsFile("taco\n"),
+		"b.txt": fsFile("burrito\n"),
+	}))
+
+	err := FSContains(expected).Matches(fstest.MapFS{
+		"b.txt": fsFile("burrito\n"),
+	})
+
+	ExpectThat(err, Error(Equals("which differs from the expected tree:\n  missing: a.txt")))
+
+	err = FSEquals(expected).Matches(fstest.MapFS{
+		"a.txt": fsFile("taco\n"),
+		"b.txt": fsFile("burrito\n"),
+	})
+
+	ExpectThat(err, Error(Equals("which differs from the expected tree:\n  extra: b.txt")))
+}
+
+func (t *FSEqualsTest) Modes() {
+	t.writeFile("run.sh", "#!/bin/sh\n", 0755)
+
+	expected := fstest.MapFS{
+		"run.sh": &fstest.MapFile{Data: []byte("#!/bin/sh\n"), Mode: 0644},
+	}
+
+	// Modes are ignored by default.
+	ExpectEq(nil, FSEquals(expected).Matches(t.dir))
+
+	err := FSEquals(expected, CompareFileModes()).Matches(t.dir)
+	ExpectThat(
+		err,
+		Error(Equals(
+			"which differs from the expected tree:\n"+
+				"  differs: run.sh (mode -rwxr-xr-x, expected -rw-r--r--)")))
+
+	expected["run.sh"].Mode = 0755
+	ExpectEq(nil, FSEquals(expected, CompareFileModes()).Matches(t.dir))
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// UpdateGoldenFiles controls the behavior of matchers returned by
+// MatchesGoldenFile. When it is true, rather than comparing candidates against
+// golden files they overwrite them, and always match. It is initialized to
+// true if the environment variable OGLEMATCHERS_UPDATE_GOLDEN is set to a
+// non-empty value. Tests that prefer a flag may bind one to it:
+//
+//     flag.BoolVar(&oglematchers.UpdateGoldenFiles, "update", false, "...")
+//
+var UpdateGoldenFiles = os.Getenv("OGLEMATCHERS_UPDATE_GOLDEN") != ""
+
+// A GoldenFileOption modifies the behavior of MatchesGoldenFile.
+type GoldenFileOption func(*goldenFileOptions)
+
+type goldenFileOptions struct {
+	normalizeLineEndings     bool
+	ignoreTrailingWhitespace bool
+}
+
+// NormalizeLineEndings causes MatchesGoldenFile to treat "\r\n" and "\r" line
+// endings as "\n", in both the candidate and the golden file.
+func NormalizeLineEndings() GoldenFileOption {
+	return func(o *goldenFileOptions) { o.normalizeLineEndings = true }
+}
+
+// IgnoreTrailingWhitespace causes MatchesGoldenFile to ignore spaces and tabs
+// at the ends of lines, in both the candidate and the golden file.
+func IgnoreTrailingWhitespace() GoldenFileOption {
+	return func(o *goldenFileOptions) { o.ignoreTrailingWhitespace = true }
+}
+
+// MatchesGoldenFile returns a matcher that matches strings and byte slices
+// whose contents are those of the file at the supplied path, typically a file
+// checked in under testdata. When the contents differ, the error shows a
+// unified diff from the golden file to the candidate.
+//
+// If UpdateGoldenFiles is true, the matcher instead writes the candidate to
+// the file (creating it and any missing directories if necessary) and
+// matches. This is the usual way to create golden files and to accept
+// intended changes:
+//
+//     OGLEMATCHERS_UPDATE_GOLDEN=1 go test ./...
+//
+// Errors reading or writing the file are reported as fatal errors.
+func MatchesGoldenFile(path string, opts ...GoldenFileOption) Matcher {
+	m := &goldenFileMatcher{path: path}
+	for _, o := range opts {
+		o(&m.opts)
+	}
+
+	return m
+}
+
+type goldenFileMatcher struct {
+	path string
+	opts goldenFileOptions
+}
+
+func (m *goldenFileMatcher) Description() string {
+	return fmt.Sprintf("matches golden file %s", m.path)
+}
+
+func (m *goldenFileMatcher) DescribeNegation() string {
+	return fmt.Sprintf("doesn't match golden file %s", m.path)
+}
+
+func (m *goldenFileMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *goldenFileMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	var actual string
+
+	v := reflect.ValueOf(c)
+	switch {
+	case v.Kind() == reflect.String:
+		actual = v.String()
+
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		actual = string(v.Bytes())
+
+	default:
+		return NewFatalError("which is not a string or []byte")
+	}
+
+	if UpdateGoldenFiles {
+		return m.update(actual)
+	}
+
+	data, err := os.ReadFile(m.path)
+	if os.IsNotExist(err) {
+		return NewFatalError(fmt.Sprintf(
+			"which can't be compared because %s doesn't exist "+
+				"(set OGLEMATCHERS_UPDATE_GOLDEN=1 to create it)",
+			m.path))
+	}
+
+	if err != nil {
+		return NewFatalError(fmt.Sprintf("which can't be compared: %v", err))
+	}
+
+	golden := m.normalize(string(data))
+	actual = m.normalize(actual)
+
+	if golden == actual {
+		return nil
+	}
+
+	return errors.New(
+		"which differs from the golden file:\n" +
+			unifiedDiff(m.path, "candidate", golden, actual))
+}
+
+func (m *goldenFileMatcher) update(actual string) error {
+	if err := os.MkdirAll(filepath.Dir(m.path), 0755); err != nil {
+		return NewFatalError(fmt.Sprintf("which couldn't be written: %v", err))
+	}
+
+	if err := os.WriteFile(m.path, []byte(actual), 0644); err != nil {
+		return NewFatalError(fmt.Sprintf("which couldn't be written: %v", err))
+	}
+
+	return nil
+}
+
+func (m *goldenFileMatcher) normalize(s string) string {
+	if m.opts.normalizeLineEndings {
+		s = strings.ReplaceAll(s, "\r\n", "\n")
+		s = strings.ReplaceAll(s, "\r", "\n")
+	}
+
+	if m.opts.ignoreTrailingWhitespace {
+		lines := strings.Split(s, "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(line, " \t")
+		}
+
+		s = strings.Join(lines, "\n")
+	}
+
+	return s
+}
@@ -0,0 +1,246 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type GoldenFileTest struct {
+	dir string
+}
+
+func init() { RegisterTestSuite(&GoldenFileTest{}) }
+
+func (t *GoldenFileTest) SetUp(i *TestInfo) {
+	var err error
+	t.dir, err = os.MkdirTemp("", "golden_file_test")
+	AssertEq(nil, err)
+}
+
+func (t *GoldenFileTest) TearDown() {
+	os.RemoveAll(t.dir)
+	UpdateGoldenFiles = false
+}
+
+func (t *GoldenFileTest) writeGolden(name string, contents string) string {
+	path := filepath.Join(t.dir, name)
+	err := os.WriteFile(path, []byte(contents), 0644)
+	AssertEq(nil, err)
+
+	return path
+}
+
+// Return the lines numbered first through last, inclusive, followed by
+// newlines.
+func numberedLines(first, last int) string {
+	var lines []string
+	for i := first; i <= last; i++ {
+		lines = append(lines, "line "+string(rune('a'+i-1))+"\n")
+	}
+
+	return strings.Join(lines, "")
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *GoldenFileTest) Description() {
+	m := MatchesGoldenFile("testdata/foo.golden")
+	ExpectEq("matches golden file testdata/foo.golden", m.Description())
+	ExpectEq("doesn't match golden file testdata/foo.golden", Not(m).Description())
+}
+
+func (t *GoldenFileTest) WrongTypeCandidates() {
+	m := MatchesGoldenFile(t.writeGolden("foo", "17"))
+
+	var err error
+
+	err = m.Matches(nil)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is not a string or []byte")))
+
+	err = m.Matches(17)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is not a string or []byte")))
+
+	err = m.Matches([]int{17})
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is not a string or []byte")))
+}
+
+func (t *GoldenFileTest) MissingFile() {
+	path := filepath.Join(t.dir, "foo")
+	err := MatchesGoldenFile(path).Matches("taco")
+
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr(path+" doesn't exist")))
+	ExpectThat(err, Error(HasSubstr("OGLEMATCHERS_UPDATE_GOLDEN=1")))
+}
+
+func (t *GoldenFileTest) Matches() {
+	m := MatchesGoldenFile(t.writeGolden("foo", "taco\nburrito\n"))
+
+	type stringAlias string
+
+	ExpectEq(nil, m.Matches("taco\nburrito\n"))
+	ExpectEq(nil, m.Matches([]byte("taco\nburrito\n")))
+	ExpectEq(nil, m.Matches(stringAlias("taco\nburrito\n")))
+}
+
+func (t *GoldenFileTest) DoesntMatch() {
+	path := t.writeGolden("foo", numberedLines(1, 20))
+	m := MatchesGoldenFile(path)
+
+	// Change line 3, delete line 10, and add a line after line 18.
+	actual := numberedLines(1, 2) +
+		"line C\n" +
+		numberedLines(4, 9) +
+		numberedLines(11, 18) +
+		"new line\n" +
+		numberedLines(19, 20)
+
+	err := m.Matches(actual)
+	ExpectFalse(isFatal(err))
+	ExpectThat(
+		err,
+		Error(Equals(
+			"which differs from the golden file:\n"+
+				"--- "+path+"\n"+
+				"+++ candidate\n"+
+				"@@ -1,13 +1,12 @@\n"+
+				" line a\n"+
+				" line b\n"+
+				"-line c\n"+
+				"+line C\n"+
+				" line d\n"+
+				" line e\n"+
+				" line f\n"+
+				" line g\n"+
+				" line h\n"+
+				" line i\n"+
+				"-line j\n"+
+				" line k\n"+
+				" line l\n"+
+				" line m\n"+
+				"@@ -16,5 +15,6 @@\n"+
+				" line p\n"+
+				" line q\n"+
+				" line r\n"+
+				"+new line\n"+
+				" line s\n"+
+				" line t\n")))
+}
+
+func (t *GoldenFileTest) CompletelyRewrittenFile() {
+	const n = 5000
+	var golden, actual strings.Builder
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&golden, "taco %d\n", i)
+		fmt.Fprintf(&actual, "burrito %d\n", i)
+	}
+
+	path := t.writeGolden("foo", golden.String())
+	err := MatchesGoldenFile(path).Matches(actual.String())
+
+	AssertNe(nil, err)
+	ExpectThat(err, Error(HasSubstr("@@ -1,5000 +1,5000 @@\n-taco 0\n")))
+	ExpectThat(err, Error(HasSubstr("-taco 4999\n+burrito 0\n")))
+	ExpectTrue(strings.HasSuffix(err.Error(), "+burrito 4999\n"))
+}
+
+func (t *GoldenFileTest) MissingTrailingNewline() {
+	path := t.writeGolden("foo", "taco\n")
+
+	err := MatchesGoldenFile(path).Matches("taco")
+	ExpectThat(
+		err,
+		Error(Equals(
+			"which differs from the golden file:\n"+
+				"--- "+path+"\n"+
+				"+++ candidate\n"+
+				"@@ -1 +1 @@\n"+
+				"-taco\n"+
+				"+taco\n"+
+				"\\ No newline at end of file\n")))
+}
+
+func (t *GoldenFileTest) EmptyGoldenFile() {
+	path := t.writeGolden("foo", "")
+
+	err := MatchesGoldenFile(path).Matches("taco\n")
+	ExpectThat(
+		err,
+		Error(Equals(
+			"which differs from the golden file:\n"+
+				"--- "+path+"\n"+
+				"+++ candidate\n"+
+				"@@ -0,0 +1 @@\n"+
+				"+taco\n")))
+}
+
+func (t *GoldenFileTest) NormalizeLineEndings() {
+	path := t.writeGolden("foo", "taco\r\nburrito\n")
+
+	ExpectNe(nil, MatchesGoldenFile(path).Matches("taco\nburrito\r\n"))
+	ExpectEq(nil, MatchesGoldenFile(path, NormalizeLineEndings()).Matches("taco\nburrito\r\n"))
+	ExpectEq(nil, MatchesGoldenFile(path, NormalizeLineEndings()).Matches("taco\rburrito\n"))
+}
+
+func (t *GoldenFileTest) IgnoreTrailingWhitespace() {
+	path := t.writeGolden("foo", "taco  \nburrito\n")
+	m := MatchesGoldenFile(path, IgnoreTrailingWhitespace())
+
+	ExpectNe(nil, MatchesGoldenFile(path).Matches("taco\nburrito\t\n"))
+	ExpectEq(nil, m.Matches("taco\nburrito\t\n"))
+	ExpectNe(nil, m.Matches(" taco\nburrito\n"))
+}
+
+func (t *GoldenFileTest) UpdateMode() {
+	path := filepath.Join(t.dir, "sub", "dir", "foo")
+	m := MatchesGoldenFile(path)
+
+	// Creating a new file
+	UpdateGoldenFiles = true
+	ExpectEq(nil, m.Matches("taco\n"))
+
+	data, err := os.ReadFile(path)
+	AssertEq(nil, err)
+	ExpectEq("taco\n", string(data))
+
+	// Overwriting
+	ExpectEq(nil, m.Matches([]byte("burrito\n")))
+
+	data, err = os.ReadFile(path)
+	AssertEq(nil, err)
+	ExpectEq("burrito\n", string(data))
+
+	// Comparing again
+	UpdateGoldenFiles = false
+	ExpectEq(nil, m.Matches("burrito\n"))
+	ExpectNe(nil, m.Matches("taco\n"))
+}
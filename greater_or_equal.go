@@ -15,11 +15,6 @@
 
 package oglematchers
 
-import (
-	"fmt"
-	"reflect"
-)
-
 // GreaterOrEqual returns a matcher that matches integer, floating point, or
 // strings values v such that v >= x. Comparison is not defined between numeric
 // and string types, but is defined between all integer and floating point
@@ -28,12 +23,10 @@ import (
 // x must itself be an integer, floating point, or string type; otherwise,
 // GreaterOrEqual will panic.
 func GreaterOrEqual(x interface{}) Matcher {
-	desc := fmt.Sprintf("greater than or equal to %v", x)
-
-	// Special case: make it clear that strings are strings.
-	if reflect.TypeOf(x).Kind() == reflect.String {
-		desc = fmt.Sprintf("greater than or equal to \"%s\"", x)
-	}
-
-	return transformDescription(Not(LessThan(x)), desc)
+	return newComparisonMatcher(
+		"GreaterOrEqual",
+		"greater than or equal to",
+		x,
+		false,
+		false)
 }
@@ -1066,7 +1066,7 @@ func (t *GreaterOrEqualTest) EmptyString() {
 func (t *GreaterOrEqualTest) SingleNullByte() {
 	matcher := GreaterOrEqual("\x00")
 	desc := matcher.Description()
-	expectedDesc := "greater than or equal to \"\x00\""
+	expectedDesc := "greater than or equal to \"\\x00\""
 
 	ExpectThat(desc, Equals(expectedDesc))
 
@@ -1083,7 +1083,7 @@ func (t *GreaterOrEqualTest) SingleNullByte() {
 func (t *GreaterOrEqualTest) LongerString() {
 	matcher := GreaterOrEqual("foo\x00")
 	desc := matcher.Description()
-	expectedDesc := "greater than or equal to \"foo\x00\""
+	expectedDesc := "greater than or equal to \"foo\\x00\""
 
 	ExpectThat(desc, Equals(expectedDesc))
 
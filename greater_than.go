@@ -15,11 +15,6 @@
 
 package oglematchers
 
-import (
-	"fmt"
-	"reflect"
-)
-
 // GreaterThan returns a matcher that matches integer, floating point, or
 // strings values v such that v > x. Comparison is not defined between numeric
 // and string types, but is defined between all integer and floating point
@@ -28,12 +23,5 @@ import (
 // x must itself be an integer, floating point, or string type; otherwise,
 // GreaterThan will panic.
 func GreaterThan(x interface{}) Matcher {
-	desc := fmt.Sprintf("greater than %v", x)
-
-	// Special case: make it clear that strings are strings.
-	if reflect.TypeOf(x).Kind() == reflect.String {
-		desc = fmt.Sprintf("greater than \"%s\"", x)
-	}
-
-	return transformDescription(Not(LessOrEqual(x)), desc)
+	return newComparisonMatcher("GreaterThan", "greater than", x, true, false)
 }
@@ -17,6 +17,8 @@ package oglematchers_test
 
 import (
 	"math"
+	"os"
+	"testing"
 
 	. "github.com/jacobsa/oglematchers"
 	. "github.com/jacobsa/ogletest"
@@ -1040,7 +1042,7 @@ func (t *GreaterThanTest) EmptyString() {
 func (t *GreaterThanTest) SingleNullByte() {
 	matcher := GreaterThan("\x00")
 	desc := matcher.Description()
-	expectedDesc := "greater than \"\x00\""
+	expectedDesc := "greater than \"\\x00\""
 
 	ExpectThat(desc, Equals(expectedDesc))
 
@@ -1058,7 +1060,7 @@ func (t *GreaterThanTest) SingleNullByte() {
 func (t *GreaterThanTest) LongerString() {
 	matcher := GreaterThan("foo\x00")
 	desc := matcher.Description()
-	expectedDesc := "greater than \"foo\x00\""
+	expectedDesc := "greater than \"foo\\x00\""
 
 	ExpectThat(desc, Equals(expectedDesc))
 
@@ -1075,3 +1077,58 @@ func (t *GreaterThanTest) LongerString() {
 
 	t.checkTestCases(matcher, cases)
 }
+
+////////////////////////////////////////////////////////////////////////
+// Allocations
+////////////////////////////////////////////////////////////////////////
+
+func (t *GreaterThanTest) MatchingDoesntAllocate() {
+	// Observing matchers, as tracing does, necessarily allocates.
+	if os.Getenv("OGLEMATCHERS_TRACE") != "" {
+		return
+	}
+
+	cases := []struct {
+		matcher   Matcher
+		candidate interface{}
+	}{
+		{GreaterThan(17), 19},
+		{GreaterThan(17), 17},
+		{GreaterThan(17), int8(19)},
+		{GreaterThan(17.5), 19.0},
+		{GreaterThan("taco"), "tacos"},
+		{GreaterOrEqual(17), 17},
+		{LessOrEqual(17), 17},
+		{LessThan(17), 19},
+	}
+
+	for _, c := range cases {
+		allocs := testing.AllocsPerRun(100, func() { c.matcher.Matches(c.candidate) })
+		ExpectEq(0, allocs, "%s, %v", c.matcher.Description(), c.candidate)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////
+// Benchmarks
+////////////////////////////////////////////////////////////////////////
+
+func benchmarkComparison(b *testing.B, matcher Matcher, c interface{}) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		matcher.Matches(c)
+	}
+}
+
+func BenchmarkGreaterThanInt(b *testing.B) {
+	benchmarkComparison(b, GreaterThan(17), 19)
+}
+
+func BenchmarkGreaterThanFloat(b *testing.B) {
+	benchmarkComparison(b, GreaterThan(17.5), float32(19))
+}
+
+func BenchmarkLessOrEqualString(b *testing.B) {
+	benchmarkComparison(b, LessOrEqual("taco"), "burrito")
+}
@@ -33,5 +33,9 @@ func HasSameTypeAs(p interface{}) Matcher {
 		return nil
 	}
 
-	return NewMatcher(pred, fmt.Sprintf("has type %v", expected))
+	return newMatcherWithNegation(
+		"HasSameTypeAs",
+		pred,
+		fmt.Sprintf("has type %v", expected),
+		fmt.Sprintf("doesn't have type %v", expected))
 }
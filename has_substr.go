@@ -16,7 +16,6 @@
 package oglematchers
 
 import (
-	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -25,15 +24,35 @@ import (
 // HasSubstr returns a matcher that matches strings containing s as a
 // substring.
 func HasSubstr(s string) Matcher {
-	return NewMatcher(
-		func(c interface{}) error { return hasSubstr(s, c) },
-		fmt.Sprintf("has substring \"%s\"", s))
+	return &hasSubstrMatcher{s}
+}
+
+type hasSubstrMatcher struct {
+	needle string
+}
+
+func (m *hasSubstrMatcher) Description() string {
+	return fmt.Sprintf("has substring %s", FormatValue(m.needle))
+}
+
+func (m *hasSubstrMatcher) DescribeNegation() string {
+	return fmt.Sprintf("doesn't have substring %s", FormatValue(m.needle))
+}
+
+func (m *hasSubstrMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *hasSubstrMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	return hasSubstr(m.needle, c)
 }
 
 func hasSubstr(needle string, c interface{}) error {
 	v := reflect.ValueOf(c)
 	if v.Kind() != reflect.String {
-		return NewFatalError("which is not a string")
+		return errNotString
 	}
 
 	// Perform the substring search.
@@ -42,5 +61,5 @@ func hasSubstr(needle string, c interface{}) error {
 		return nil
 	}
 
-	return errors.New("")
+	return errNoMatch
 }
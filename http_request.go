@@ -0,0 +1,271 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// The matchers in this file accept candidates of type *http.Request, such as
+// the argument to a mocked http.RoundTripper or an http.Handler. In each case
+// a string is given to m, and if m is not a Matcher it is treated as
+// Equals(m). For example:
+//
+//     ExpectThat(req, AllOf(RequestMethod("POST"), RequestPath("/tacos")))
+//
+
+// RequestMethod returns a matcher that matches requests whose method matches
+// m.
+func RequestMethod(m interface{}) Matcher {
+	return newRequestMatcher(
+		"RequestMethod",
+		nil,
+		"method",
+		func(r *http.Request) (string, bool) { return r.Method, true },
+		m)
+}
+
+// RequestURL returns a matcher that matches requests whose URL, as returned
+// by its String method, matches m.
+func RequestURL(m interface{}) Matcher {
+	return newRequestMatcher(
+		"RequestURL",
+		nil,
+		"URL",
+		func(r *http.Request) (string, bool) {
+			if r.URL == nil {
+				return "", false
+			}
+
+			return r.URL.String(), true
+		},
+		m)
+}
+
+// RequestPath returns a matcher that matches requests whose URL path matches
+// m.
+func RequestPath(m interface{}) Matcher {
+	return newRequestMatcher(
+		"RequestPath",
+		nil,
+		"path",
+		func(r *http.Request) (string, bool) {
+			if r.URL == nil {
+				return "", false
+			}
+
+			return r.URL.Path, true
+		},
+		m)
+}
+
+// RequestQuery returns a matcher that matches requests with a URL query
+// parameter named key whose value matches m. If the parameter has several
+// values, they are joined with ", " before matching. Requests without the
+// parameter don't match.
+func RequestQuery(key string, m interface{}) Matcher {
+	return newRequestMatcher(
+		"RequestQuery",
+		[]interface{}{key},
+		fmt.Sprintf("query parameter %s", FormatValue(key)),
+		func(r *http.Request) (string, bool) {
+			if r.URL == nil {
+				return "", false
+			}
+
+			values, ok := r.URL.Query()[key]
+			return strings.Join(values, ", "), ok
+		},
+		m)
+}
+
+// RequestHeader returns a matcher that matches requests with a header having
+// the supplied name whose value matches m. If the header has several values,
+// they are joined with ", " before matching. Requests without the header
+// don't match.
+func RequestHeader(name string, m interface{}) Matcher {
+	name = http.CanonicalHeaderKey(name)
+	return newRequestMatcher(
+		"RequestHeader",
+		[]interface{}{name},
+		fmt.Sprintf("%s header", name),
+		func(r *http.Request) (string, bool) {
+			values := r.Header.Values(name)
+			return strings.Join(values, ", "), len(values) > 0
+		},
+		m)
+}
+
+// RequestBody returns a matcher that matches requests whose body matches m.
+// As with ReaderContents, the body is given to m as a string, and then as a
+// []byte if that results in a fatal error. The request's Body is replaced
+// with a reader for the same data, so that it may be read again by the code
+// under test.
+func RequestBody(m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &requestBodyMatcher{wrapped}
+}
+
+// Extract the request from a candidate, or return a fatal error.
+func candidateRequest(c interface{}) (*http.Request, error) {
+	r, ok := c.(*http.Request)
+	if !ok || r == nil {
+		return nil, NewFatalError("which is not a non-nil *http.Request")
+	}
+
+	return r, nil
+}
+
+////////////////////////////////////////////////////////////////////////
+// Method, URL, path, query, and header
+////////////////////////////////////////////////////////////////////////
+
+// Return a matcher created by the constructor with the supplied name, given
+// the supplied arguments preceding m, for MarshalMatcher.
+func newRequestMatcher(
+	name string,
+	args []interface{},
+	noun string,
+	get func(*http.Request) (string, bool),
+	m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &requestMatcher{name, args, noun, get, wrapped}
+}
+
+type requestMatcher struct {
+	name string
+	args []interface{}
+	noun string
+
+	// Return the relevant part of the request, or false if it is absent.
+	get func(*http.Request) (string, bool)
+
+	wrapped Matcher
+}
+
+func (m *requestMatcher) spec() (string, []interface{}) {
+	return m.name, append(append([]interface{}{}, m.args...), m.wrapped)
+}
+
+func (m *requestMatcher) Description() string {
+	return fmt.Sprintf("request %s matches: %s", m.noun, m.wrapped.Description())
+}
+
+func (m *requestMatcher) DescribeNegation() string {
+	return fmt.Sprintf("request %s doesn't match: %s", m.noun, m.wrapped.Description())
+}
+
+func (m *requestMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *requestMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	r, err := candidateRequest(c)
+	if err != nil {
+		return err
+	}
+
+	value, ok := m.get(r)
+	if !ok {
+		return errors.New(fmt.Sprintf("which has no %s", m.noun))
+	}
+
+	if err = o.matches(m.wrapped, value); err == nil {
+		return nil
+	}
+
+	wrappedClause := ""
+	if err.Error() != "" {
+		wrappedClause = ", " + err.Error()
+	}
+
+	s := fmt.Sprintf("whose %s is %s%s", m.noun, FormatValue(value), wrappedClause)
+	if _, isFatal := err.(*FatalError); isFatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
+
+////////////////////////////////////////////////////////////////////////
+// Body
+////////////////////////////////////////////////////////////////////////
+
+type requestBodyMatcher struct {
+	wrapped Matcher
+}
+
+func (m *requestBodyMatcher) Description() string {
+	return fmt.Sprintf("request body matches: %s", m.wrapped.Description())
+}
+
+func (m *requestBodyMatcher) DescribeNegation() string {
+	return fmt.Sprintf("request body doesn't match: %s", m.wrapped.Description())
+}
+
+func (m *requestBodyMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *requestBodyMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	r, err := candidateRequest(c)
+	if err != nil {
+		return err
+	}
+
+	var body []byte
+	if r.Body != nil && r.Body != http.NoBody {
+		body, err = io.ReadAll(r.Body)
+		r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		if err != nil {
+			return NewFatalError(fmt.Sprintf("whose body couldn't be read: %v", err))
+		}
+	}
+
+	if err = matchStringOrBytes(o, m.wrapped, body); err == nil {
+		return nil
+	}
+
+	s := "whose body is " + FormatValue(string(body))
+	if err.Error() != "" {
+		s += ", " + err.Error()
+	}
+
+	if _, isFatal := err.(*FatalError); isFatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
@@ -0,0 +1,156 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type HTTPRequestTest struct {
+	req *http.Request
+}
+
+func init() { RegisterTestSuite(&HTTPRequestTest{}) }
+
+func (t *HTTPRequestTest) SetUp(i *TestInfo) {
+	t.req = httptest.NewRequest(
+		"POST",
+		"http://example.com/tacos?size=large&topping=salsa&topping=queso",
+		strings.NewReader(`{"name": "taco"}`))
+
+	t.req.Header.Set("Content-Type", "application/json")
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *HTTPRequestTest) Descriptions() {
+	ExpectEq("request method matches: \"POST\"", RequestMethod("POST").Description())
+	ExpectEq(
+		"request URL matches: has substring \"tacos\"",
+		RequestURL(HasSubstr("tacos")).Description())
+	ExpectEq("request path matches: \"/tacos\"", RequestPath("/tacos").Description())
+	ExpectEq(
+		"request query parameter \"size\" matches: \"large\"",
+		RequestQuery("size", "large").Description())
+	ExpectEq(
+		"request Content-Type header matches: \"application/json\"",
+		RequestHeader("content-type", "application/json").Description())
+	ExpectEq(
+		"request body matches: has substring \"taco\"",
+		RequestBody(HasSubstr("taco")).Description())
+
+	ExpectEq(
+		"request method doesn't match: \"GET\"",
+		Not(RequestMethod("GET")).Description())
+	ExpectEq(
+		"request method matches: \"POST\", and request path matches: \"/tacos\"",
+		AllOf(RequestMethod("POST"), RequestPath("/tacos")).Description())
+}
+
+func (t *HTTPRequestTest) WrongTypeCandidates() {
+	matchers := []Matcher{
+		RequestMethod(""),
+		RequestURL(""),
+		RequestPath(""),
+		RequestQuery("", ""),
+		RequestHeader("", ""),
+		RequestBody(""),
+	}
+
+	for _, m := range matchers {
+		for _, c := range []interface{}{nil, 17, http.Request{}, (*http.Request)(nil)} {
+			err := m.Matches(c)
+			ExpectTrue(isFatal(err))
+			ExpectThat(err, Error(Equals("which is not a non-nil *http.Request")))
+		}
+	}
+}
+
+func (t *HTTPRequestTest) Method() {
+	ExpectEq(nil, RequestMethod("POST").Matches(t.req))
+
+	err := RequestMethod("GET").Matches(t.req)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose method is \"POST\"")))
+}
+
+func (t *HTTPRequestTest) URLAndPath() {
+	ExpectEq(nil, RequestURL(HasSubstr("example.com/tacos?")).Matches(t.req))
+	ExpectEq(nil, RequestPath("/tacos").Matches(t.req))
+
+	err := RequestPath(HasSubstr("burrito")).Matches(t.req)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose path is \"/tacos\"")))
+
+	err = RequestPath(LessThan(17)).Matches(t.req)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("whose path is \"/tacos\", ")))
+
+	err = RequestPath("").Matches(&http.Request{})
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has no path")))
+}
+
+func (t *HTTPRequestTest) Query() {
+	ExpectEq(nil, RequestQuery("size", "large").Matches(t.req))
+	ExpectEq(nil, RequestQuery("topping", "salsa, queso").Matches(t.req))
+
+	err := RequestQuery("size", "small").Matches(t.req)
+	ExpectThat(err, Error(Equals("whose query parameter \"size\" is \"large\"")))
+
+	err = RequestQuery("spicy", Any()).Matches(t.req)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has no query parameter \"spicy\"")))
+}
+
+func (t *HTTPRequestTest) Header() {
+	ExpectEq(nil, RequestHeader("content-type", HasSubstr("json")).Matches(t.req))
+
+	err := RequestHeader("Content-Type", "text/plain").Matches(t.req)
+	ExpectThat(err, Error(Equals("whose Content-Type header is \"application/json\"")))
+
+	err = RequestHeader("Authorization", Any()).Matches(t.req)
+	ExpectThat(err, Error(Equals("which has no Authorization header")))
+	ExpectEq(nil, Not(RequestHeader("Authorization", Any())).Matches(t.req))
+}
+
+func (t *HTTPRequestTest) Body() {
+	ExpectEq(nil, RequestBody(`{"name": "taco"}`).Matches(t.req))
+	ExpectEq(nil, RequestBody(HasSubstr("taco")).Matches(t.req))
+	ExpectEq(nil, RequestBody(DeepEquals([]byte(`{"name": "taco"}`))).Matches(t.req))
+	ExpectEq(nil, RequestBody("").Matches(httptest.NewRequest("GET", "/", nil)))
+
+	err := RequestBody(HasSubstr("burrito")).Matches(t.req)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose body is \"{\\\"name\\\": \\\"taco\\\"}\"")))
+
+	// The body is still available.
+	data, err := io.ReadAll(t.req.Body)
+	AssertEq(nil, err)
+	ExpectEq(`{"name": "taco"}`, string(data))
+}
@@ -0,0 +1,382 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// The matchers in this file accept candidates of type *http.Response or
+// *httptest.ResponseRecorder. Reading the body doesn't prevent others from
+// reading it again: an *http.Response's Body is replaced with a reader for
+// the same data, and a recorder's Body buffer is left untouched.
+//
+// A recorder's status and headers are read afresh each time, so the matchers
+// see writes made after an earlier match. Unlike with a real response, this
+// includes headers set after the status was written.
+//
+// Failure messages include a snippet of the body, truncated to a few hundred
+// bytes, since it often explains an unexpected status or header.
+
+// HTTPStatus returns a matcher that matches HTTP responses whose status code,
+// as an int, matches m. If m is not a Matcher, it is treated as Equals(m).
+//
+//     ExpectThat(recorder, HTTPStatus(http.StatusOK))
+//
+func HTTPStatus(m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &httpStatusMatcher{wrapped}
+}
+
+// HTTPHeader returns a matcher that matches HTTP responses with a header
+// having the supplied name whose value matches m. If the header has several
+// values, they are joined with ", " before matching. Responses without the
+// header don't match. If m is not a Matcher, it is treated as Equals(m).
+func HTTPHeader(name string, m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &httpHeaderMatcher{http.CanonicalHeaderKey(name), wrapped}
+}
+
+// HTTPBody returns a matcher that matches HTTP responses whose body matches
+// m. As with ReaderContents, the body is given to m as a string, and then as
+// a []byte if that results in a fatal error. If m is not a Matcher, it is
+// treated as Equals(m).
+func HTTPBody(m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &httpBodyMatcher{wrapped}
+}
+
+// HTTPJSONBody returns a matcher that matches HTTP responses whose body is
+// valid JSON that, when decoded into an interface{} using encoding/json,
+// matches m. Objects are therefore decoded as map[string]interface{}, arrays
+// as []interface{}, and numbers as float64. If m is not a Matcher, it is
+// treated as Equals(m).
+//
+//     ExpectThat(resp, HTTPJSONBody(At("user.name", "taco")))
+//
+func HTTPJSONBody(m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &httpJSONBodyMatcher{wrapped}
+}
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+// The maximum length of body snippets in failure messages.
+const httpBodySnippetLength = 256
+
+// Return the status and headers for the supplied candidate, or a fatal error.
+func httpResponseHead(c interface{}) (status int, header http.Header, err error) {
+	switch r := c.(type) {
+	case *http.Response:
+		if r != nil {
+			return r.StatusCode, r.Header, nil
+		}
+
+	case *httptest.ResponseRecorder:
+		if r != nil {
+			// Don't use Result, which caches its first result and so would hide
+			// later writes. This means that headers changed after the status
+			// was written are seen, unlike with a real response.
+			status = r.Code
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			return status, r.Header().Clone(), nil
+		}
+	}
+
+	err = NewFatalError("which is not a non-nil *http.Response or *httptest.ResponseRecorder")
+	return
+}
+
+// Return the body of the supplied candidate without consuming it, or a fatal
+// error.
+func httpResponseBody(c interface{}) ([]byte, error) {
+	switch r := c.(type) {
+	case *http.Response:
+		if r == nil {
+			break
+		}
+
+		if r.Body == nil || r.Body == http.NoBody {
+			return nil, nil
+		}
+
+		data, err := io.ReadAll(r.Body)
+		r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(data))
+
+		if err != nil {
+			return nil, NewFatalError(fmt.Sprintf("whose body couldn't be read: %v", err))
+		}
+
+		return data, nil
+
+	case *httptest.ResponseRecorder:
+		if r == nil {
+			break
+		}
+
+		if r.Body == nil {
+			return nil, nil
+		}
+
+		return r.Body.Bytes(), nil
+	}
+
+	return nil, NewFatalError("which is not a non-nil *http.Response or *httptest.ResponseRecorder")
+}
+
+// Return a quoted, possibly truncated, snippet of the supplied body.
+func bodySnippet(data []byte) string {
+	if len(data) <= httpBodySnippetLength {
+		return strconv.Quote(string(data))
+	}
+
+	n := httpBodySnippetLength
+	for n > 0 && !utf8.RuneStart(data[n]) {
+		n--
+	}
+
+	return strconv.Quote(string(data[:n])) + "..."
+}
+
+// Build an error for a failed match of some part of an HTTP response,
+// including a body snippet. wrappedErr is the error from the wrapped matcher,
+// and determines whether the result is fatal.
+func httpResponseError(c interface{}, clause string, wrappedErr error) error {
+	s := clause
+	if wrappedErr != nil && wrappedErr.Error() != "" {
+		s += ", " + wrappedErr.Error()
+	}
+
+	if body, err := httpResponseBody(c); err == nil {
+		s += ", and whose body is " + bodySnippet(body)
+	}
+
+	if _, isFatal := wrappedErr.(*FatalError); isFatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
+
+////////////////////////////////////////////////////////////////////////
+// HTTPStatus
+////////////////////////////////////////////////////////////////////////
+
+type httpStatusMatcher struct {
+	wrapped Matcher
+}
+
+func (m *httpStatusMatcher) Description() string {
+	return fmt.Sprintf("whose HTTP status matches: %s", m.wrapped.Description())
+}
+
+func (m *httpStatusMatcher) DescribeNegation() string {
+	return fmt.Sprintf("whose HTTP status doesn't match: %s", m.wrapped.Description())
+}
+
+func (m *httpStatusMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *httpStatusMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	status, _, err := httpResponseHead(c)
+	if err != nil {
+		return err
+	}
+
+	if err = o.matches(m.wrapped, status); err == nil {
+		return nil
+	}
+
+	clause := fmt.Sprintf("whose HTTP status is %d", status)
+	if text := http.StatusText(status); text != "" {
+		clause += " (" + text + ")"
+	}
+
+	return httpResponseError(c, clause, err)
+}
+
+////////////////////////////////////////////////////////////////////////
+// HTTPHeader
+////////////////////////////////////////////////////////////////////////
+
+type httpHeaderMatcher struct {
+	name    string
+	wrapped Matcher
+}
+
+func (m *httpHeaderMatcher) Description() string {
+	return fmt.Sprintf("whose %s header matches: %s", m.name, m.wrapped.Description())
+}
+
+func (m *httpHeaderMatcher) DescribeNegation() string {
+	return fmt.Sprintf("whose %s header doesn't match: %s", m.name, m.wrapped.Description())
+}
+
+func (m *httpHeaderMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *httpHeaderMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	_, header, err := httpResponseHead(c)
+	if err != nil {
+		return err
+	}
+
+	values := header.Values(m.name)
+	if len(values) == 0 {
+		return httpResponseError(c, fmt.Sprintf("which has no %s header", m.name), nil)
+	}
+
+	value := strings.Join(values, ", ")
+	if err = o.matches(m.wrapped, value); err == nil {
+		return nil
+	}
+
+	clause := fmt.Sprintf("whose %s header is %s", m.name, FormatValue(value))
+	return httpResponseError(c, clause, err)
+}
+
+////////////////////////////////////////////////////////////////////////
+// HTTPBody
+////////////////////////////////////////////////////////////////////////
+
+type httpBodyMatcher struct {
+	wrapped Matcher
+}
+
+func (m *httpBodyMatcher) Description() string {
+	return fmt.Sprintf("whose HTTP body matches: %s", m.wrapped.Description())
+}
+
+func (m *httpBodyMatcher) DescribeNegation() string {
+	return fmt.Sprintf("whose HTTP body doesn't match: %s", m.wrapped.Description())
+}
+
+func (m *httpBodyMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *httpBodyMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	body, err := httpResponseBody(c)
+	if err != nil {
+		return err
+	}
+
+	if err = matchStringOrBytes(o, m.wrapped, body); err == nil {
+		return nil
+	}
+
+	s := "whose body is " + bodySnippet(body)
+	if err.Error() != "" {
+		s += ", " + err.Error()
+	}
+
+	if _, isFatal := err.(*FatalError); isFatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
+
+////////////////////////////////////////////////////////////////////////
+// HTTPJSONBody
+////////////////////////////////////////////////////////////////////////
+
+type httpJSONBodyMatcher struct {
+	wrapped Matcher
+}
+
+func (m *httpJSONBodyMatcher) Description() string {
+	return fmt.Sprintf("whose HTTP body is JSON matching: %s", m.wrapped.Description())
+}
+
+func (m *httpJSONBodyMatcher) DescribeNegation() string {
+	return fmt.Sprintf("whose HTTP body is not JSON matching: %s", m.wrapped.Description())
+}
+
+func (m *httpJSONBodyMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *httpJSONBodyMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	body, err := httpResponseBody(c)
+	if err != nil {
+		return err
+	}
+
+	var decoded interface{}
+	if err = json.Unmarshal(body, &decoded); err != nil {
+		return NewFatalError(fmt.Sprintf(
+			"whose body is %s, which is not valid JSON: %v",
+			bodySnippet(body),
+			err))
+	}
+
+	if err = o.matches(m.wrapped, decoded); err == nil {
+		return nil
+	}
+
+	s := "whose JSON body is " + FormatValue(decoded)
+	if err.Error() != "" {
+		s += ", " + err.Error()
+	}
+
+	if _, isFatal := err.(*FatalError); isFatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
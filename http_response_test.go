@@ -0,0 +1,206 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type HTTPResponseTest struct {
+}
+
+func init() { RegisterTestSuite(&HTTPResponseTest{}) }
+
+func newResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func newRecorder(status int, body string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Content-Type", "application/json")
+	rec.Header().Add("Vary", "Accept")
+	rec.Header().Add("Vary", "Cookie")
+	rec.WriteHeader(status)
+	io.WriteString(rec, body)
+
+	return rec
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *HTTPResponseTest) Descriptions() {
+	ExpectEq("whose HTTP status matches: 200", HTTPStatus(200).Description())
+	ExpectEq("whose HTTP status doesn't match: 200", Not(HTTPStatus(200)).Description())
+	ExpectEq(
+		"whose Content-Type header matches: has substring \"json\"",
+		HTTPHeader("content-type", HasSubstr("json")).Description())
+	ExpectEq("whose HTTP body matches: \"taco\"", HTTPBody("taco").Description())
+	ExpectEq(
+		"whose HTTP body is JSON matching: whose name matches: \"taco\"",
+		HTTPJSONBody(At("name", "taco")).Description())
+}
+
+func (t *HTTPResponseTest) WrongTypeCandidates() {
+	matchers := []Matcher{
+		HTTPStatus(200),
+		HTTPHeader("Foo", ""),
+		HTTPBody(""),
+		HTTPJSONBody(Any()),
+	}
+
+	candidates := []interface{}{
+		nil,
+		17,
+		http.Response{},
+		(*http.Response)(nil),
+		(*httptest.ResponseRecorder)(nil),
+	}
+
+	for _, m := range matchers {
+		for _, c := range candidates {
+			err := m.Matches(c)
+			ExpectTrue(isFatal(err))
+			ExpectThat(
+				err,
+				Error(Equals("which is not a non-nil *http.Response or *httptest.ResponseRecorder")))
+		}
+	}
+}
+
+func (t *HTTPResponseTest) Status() {
+	ExpectEq(nil, HTTPStatus(200).Matches(newResponse(200, "")))
+	ExpectEq(nil, HTTPStatus(http.StatusCreated).Matches(newRecorder(201, "")))
+	ExpectEq(nil, HTTPStatus(LessThan(300)).Matches(httptest.NewRecorder()))
+
+	err := HTTPStatus(200).Matches(newRecorder(404, "no such taco"))
+	ExpectFalse(isFatal(err))
+	ExpectThat(
+		err,
+		Error(Equals("whose HTTP status is 404 (Not Found), and whose body is \"no such taco\"")))
+
+	err = HTTPStatus(HasSubstr("2")).Matches(newResponse(599, ""))
+	ExpectTrue(isFatal(err))
+	ExpectThat(
+		err,
+		Error(Equals("whose HTTP status is 599, which is not a string, and whose body is \"\"")))
+}
+
+func (t *HTTPResponseTest) Header() {
+	rec := newRecorder(200, "")
+
+	ExpectEq(nil, HTTPHeader("Content-Type", "application/json").Matches(rec))
+	ExpectEq(nil, HTTPHeader("content-type", HasSubstr("json")).Matches(newResponse(200, "")))
+	ExpectEq(nil, HTTPHeader("Vary", "Accept, Cookie").Matches(rec))
+
+	err := HTTPHeader("Content-Type", "text/html").Matches(rec)
+	ExpectFalse(isFatal(err))
+	ExpectThat(
+		err,
+		Error(Equals("whose Content-Type header is \"application/json\", and whose body is \"\"")))
+
+	err = HTTPHeader("Location", Any()).Matches(rec)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has no Location header, and whose body is \"\"")))
+
+	ExpectEq(nil, Not(HTTPHeader("Location", Any())).Matches(rec))
+}
+
+func (t *HTTPResponseTest) RecordersAreReadAfresh() {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("X-Taco", "carnitas")
+	ExpectEq(nil, HTTPStatus(200).Matches(rec))
+	ExpectEq(nil, HTTPHeader("X-Taco", "carnitas").Matches(rec))
+
+	rec.Header().Set("X-Taco", "al pastor")
+	rec.WriteHeader(http.StatusTeapot)
+
+	ExpectEq(nil, HTTPStatus(http.StatusTeapot).Matches(rec))
+	ExpectEq(nil, HTTPHeader("X-Taco", "al pastor").Matches(rec))
+
+	// A zero recorder has the default status.
+	ExpectEq(nil, HTTPStatus(200).Matches(&httptest.ResponseRecorder{}))
+}
+
+func (t *HTTPResponseTest) Body() {
+	ExpectEq(nil, HTTPBody("taco").Matches(newRecorder(200, "taco")))
+	ExpectEq(nil, HTTPBody(HasSubstr("ac")).Matches(newResponse(200, "taco")))
+	ExpectEq(nil, HTTPBody(DeepEquals([]byte("taco"))).Matches(newResponse(200, "taco")))
+	ExpectEq(nil, HTTPBody("").Matches(&http.Response{}))
+
+	err := HTTPBody(HasSubstr("burrito")).Matches(newResponse(200, "taco"))
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose body is \"taco\"")))
+}
+
+func (t *HTTPResponseTest) BodyIsNotConsumed() {
+	resp := newResponse(200, `{"name": "taco"}`)
+	rec := newRecorder(200, `{"name": "taco"}`)
+
+	for _, c := range []interface{}{resp, rec} {
+		ExpectEq(nil, HTTPBody(HasSubstr("taco")).Matches(c))
+		ExpectEq(nil, HTTPBody(HasSubstr("name")).Matches(c))
+		ExpectEq(nil, HTTPJSONBody(At("name", "taco")).Matches(c))
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	AssertEq(nil, err)
+	ExpectEq(`{"name": "taco"}`, string(data))
+	ExpectEq(`{"name": "taco"}`, rec.Body.String())
+}
+
+func (t *HTTPResponseTest) LongBodiesAreTruncated() {
+	body := strings.Repeat("a", 1000)
+
+	err := HTTPStatus(200).Matches(newResponse(500, body))
+	ExpectThat(
+		err,
+		Error(Equals(
+			"whose HTTP status is 500 (Internal Server Error), "+
+				"and whose body is \""+strings.Repeat("a", 256)+"\"...")))
+}
+
+func (t *HTTPResponseTest) JSONBody() {
+	rec := newRecorder(200, `{"name": "taco", "toppings": ["salsa", "queso"], "price": 3}`)
+
+	ExpectEq(nil, HTTPJSONBody(At("name", "taco")).Matches(rec))
+	ExpectEq(nil, HTTPJSONBody(At("toppings[1]", "queso")).Matches(rec))
+	ExpectEq(nil, HTTPJSONBody(At("price", 3)).Matches(rec))
+
+	err := HTTPJSONBody(At("price", LessThan(3))).Matches(rec)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("whose JSON body is map[\"name\":\"taco\" \"price\":3")))
+	ExpectThat(err, Error(HasSubstr("whose price is 3")))
+
+	err = HTTPJSONBody(Any()).Matches(newResponse(200, "taco"))
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("whose body is \"taco\", which is not valid JSON: ")))
+}
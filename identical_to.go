@@ -98,10 +98,21 @@ type identicalToMatcher struct {
 
 func (m *identicalToMatcher) Description() string {
 	t := reflect.TypeOf(m.x)
-	return fmt.Sprintf("identical to <%v> %v", t, m.x)
+	return fmt.Sprintf("identical to <%v> %s", t, FormatValue(m.x))
+}
+
+func (m *identicalToMatcher) DescribeNegation() string {
+	t := reflect.TypeOf(m.x)
+	return fmt.Sprintf("not identical to <%v> %s", t, FormatValue(m.x))
 }
 
 func (m *identicalToMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *identicalToMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
 	// Make sure the candidate's type is correct.
 	t := reflect.TypeOf(m.x)
 	if ct := reflect.TypeOf(c); t != ct {
@@ -130,5 +141,5 @@ func (m *identicalToMatcher) Matches(c interface{}) error {
 		return nil
 	}
 
-	return errors.New("")
+	return errNoMatch
 }
@@ -98,7 +98,7 @@ func (t *IdenticalToTest) Slices() {
 
 	// Nil expected value
 	m = IdenticalTo(([]int)(nil))
-	ExpectEq("identical to <[]int> []", m.Description())
+	ExpectEq("identical to <[]int> <nil slice>", m.Description())
 
 	err = m.Matches(([]int)(nil))
 	ExpectEq(nil, err)
@@ -125,7 +125,7 @@ func (t *IdenticalToTest) Maps() {
 
 	// Nil expected value
 	m = IdenticalTo((map[int]int)(nil))
-	ExpectEq("identical to <map[int]int> map[]", m.Description())
+	ExpectEq("identical to <map[int]int> <nil map>", m.Description())
 
 	err = m.Matches((map[int]int)(nil))
 	ExpectEq(nil, err)
@@ -687,7 +687,7 @@ func (t *IdenticalToTest) Strings() {
 	var err error
 
 	m = IdenticalTo("taco")
-	ExpectEq("identical to <string> taco", m.Description())
+	ExpectEq("identical to <string> \"taco\"", m.Description())
 
 	// Identical value
 	err = m.Matches("ta" + "co")
@@ -720,7 +720,7 @@ func (t *IdenticalToTest) ComparableStructs() {
 
 	x := myStruct{17, subStruct{19}}
 	m = IdenticalTo(x)
-	ExpectEq("identical to <oglematchers_test.myStruct> {17 {19}}", m.Description())
+	ExpectEq("identical to <oglematchers_test.myStruct> {u:17 s:{i:19}}", m.Description())
 
 	// Identical value
 	err = m.Matches(myStruct{17, subStruct{19}})
@@ -1040,7 +1040,7 @@ func (t *LessOrEqualTest) EmptyString() {
 func (t *LessOrEqualTest) SingleNullByte() {
 	matcher := LessOrEqual("\x00")
 	desc := matcher.Description()
-	expectedDesc := "less than or equal to \"\x00\""
+	expectedDesc := "less than or equal to \"\\x00\""
 
 	ExpectThat(desc, Equals(expectedDesc))
 
@@ -1058,7 +1058,7 @@ func (t *LessOrEqualTest) SingleNullByte() {
 func (t *LessOrEqualTest) LongerString() {
 	matcher := LessOrEqual("foo\x00")
 	desc := matcher.Description()
-	expectedDesc := "less than or equal to \"foo\x00\""
+	expectedDesc := "less than or equal to \"foo\\x00\""
 
 	ExpectThat(desc, Equals(expectedDesc))
 
@@ -16,7 +16,6 @@
 package oglematchers
 
 import (
-	"errors"
 	"fmt"
 	"math"
 	"reflect"
@@ -29,19 +28,7 @@ import (
 // x must itself be an integer, floating point, or string type; otherwise,
 // LessThan will panic.
 func LessThan(x interface{}) Matcher {
-	v := reflect.ValueOf(x)
-	kind := v.Kind()
-
-	switch {
-	case isInteger(v):
-	case isFloat(v):
-	case kind == reflect.String:
-
-	default:
-		panic(fmt.Sprintf("LessThan: unexpected kind %v", kind))
-	}
-
-	return &lessThanMatcher{v}
+	return &lessThanMatcher{comparisonLimit("LessThan", x)}
 }
 
 type lessThanMatcher struct {
@@ -49,16 +36,15 @@ type lessThanMatcher struct {
 }
 
 func (m *lessThanMatcher) Description() string {
-	// Special case: make it clear that strings are strings.
-	if m.limit.Kind() == reflect.String {
-		return fmt.Sprintf("less than \"%s\"", m.limit.String())
-	}
+	return fmt.Sprintf("less than %s", FormatValue(m.limit.Interface()))
+}
 
-	return fmt.Sprintf("less than %v", m.limit.Interface())
+func (m *lessThanMatcher) DescribeNegation() string {
+	return fmt.Sprintf("is not less than %s", FormatValue(m.limit.Interface()))
 }
 
 func compareIntegers(v1, v2 reflect.Value) (err error) {
-	err = errors.New("")
+	err = errNoMatch
 
 	switch {
 	case isSignedInteger(v1) && isSignedInteger(v2):
@@ -104,11 +90,41 @@ func getFloat(v reflect.Value) float64 {
 	panic(fmt.Sprintf("getFloat: %v", v))
 }
 
-func (m *lessThanMatcher) Matches(c interface{}) (err error) {
-	v1 := reflect.ValueOf(c)
+func (m *lessThanMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *lessThanMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	return m.matches(c)
+}
+
+func (m *lessThanMatcher) matches(c interface{}) (err error) {
 	v2 := m.limit
 
-	err = errors.New("")
+	// Fast paths for the most common candidate types, for which the rules
+	// below boil down to a direct comparison.
+	switch c := c.(type) {
+	case int:
+		if isSignedInteger(v2) {
+			return matchIf(int64(c) < v2.Int())
+		}
+
+	case float64:
+		if v2.Kind() == reflect.Float64 {
+			return matchIf(c < v2.Float())
+		}
+
+	case string:
+		if v2.Kind() == reflect.String {
+			return matchIf(c < v2.String())
+		}
+	}
+
+	v1 := reflect.ValueOf(c)
+
+	err = errNoMatch
 
 	// Handle strings as a special case.
 	if v1.Kind() == reflect.String && v2.Kind() == reflect.String {
@@ -122,7 +138,7 @@ func (m *lessThanMatcher) Matches(c interface{}) (err error) {
 	v1Legal := isInteger(v1) || isFloat(v1)
 	v2Legal := isInteger(v2) || isFloat(v2)
 	if !v1Legal || !v2Legal {
-		err = NewFatalError("which is not comparable")
+		err = errNotComparable
 		return
 	}
 
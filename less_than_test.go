@@ -1022,7 +1022,7 @@ func (t *LessThanTest) EmptyString() {
 func (t *LessThanTest) SingleNullByte() {
 	matcher := LessThan("\x00")
 	desc := matcher.Description()
-	expectedDesc := "less than \"\x00\""
+	expectedDesc := "less than \"\\x00\""
 
 	ExpectThat(desc, Equals(expectedDesc))
 
@@ -1039,7 +1039,7 @@ func (t *LessThanTest) SingleNullByte() {
 func (t *LessThanTest) LongerString() {
 	matcher := LessThan("foo\x00")
 	desc := matcher.Description()
-	expectedDesc := "less than \"foo\x00\""
+	expectedDesc := "less than \"foo\\x00\""
 
 	ExpectThat(desc, Equals(expectedDesc))
 
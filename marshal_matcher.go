@@ -0,0 +1,814 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MarshalMatcher encodes the supplied matcher tree as JSON, so that it may be
+// stored in a fixture file or sent elsewhere and later rebuilt with
+// UnmarshalMatcher. Each matcher is encoded as an object naming its
+// constructor along with the arguments it was given, for example:
+//
+//     {"matcher":"AllOf","args":[
+//       {"matcher":"GreaterOrEqual","args":[2]},
+//       {"matcher":"LessThan","args":[10]}]}
+//
+// Arguments are either nested matchers, or JSON scalars: null, booleans,
+// numbers, and strings. Arguments that were converted to matchers by the
+// constructor, such as the 17 in Contains(17), appear as Equals matchers.
+//
+// The "len" and "contains" expressions of ParseMatcher are encoded under
+// those names.
+//
+// Most built-in matchers are supported. Matchers that depend on Go values
+// that have no JSON equivalent are not, and cause an error to be returned.
+// These are:
+//
+//  *  matchers created with NewMatcher, Panics, and ResultOf, which contain
+//     functions;
+//  *  IdenticalTo, Capture, CaptureAll, and SameAsCaptured, which refer to
+//     particular variables;
+//  *  HasSameTypeAs, WhenDynamicTypeIs, and the type matchers such as
+//     Implements and KindIs, which depend on Go types;
+//  *  ContextValue, and ContextErr given an error value rather than a
+//     matcher;
+//  *  FSEquals, FSContains, MatchesGoldenFile, MatchesSnapshot,
+//     DeepEqualsWith, and EqualsSemantic;
+//  *  DeepEquals for values of types other than bool, int, float64, and
+//     string.
+//
+// Other matchers may take part by registering with RegisterMatcherCodec.
+func MarshalMatcher(m Matcher) ([]byte, error) {
+	raw, err := encodeMatcher(m)
+	if err != nil {
+		return nil, errors.New("MarshalMatcher: " + err.Error())
+	}
+
+	return raw, nil
+}
+
+// UnmarshalMatcher rebuilds a matcher tree encoded by MarshalMatcher. Numbers
+// without a fraction or exponent are decoded as int (or int64 or uint64 if
+// out of range), and other numbers as float64.
+func UnmarshalMatcher(data []byte) (Matcher, error) {
+	m, err := decodeMatcher(data)
+	if err != nil {
+		return nil, errors.New("UnmarshalMatcher: " + err.Error())
+	}
+
+	return m, nil
+}
+
+// RegisterMatcherCodec arranges for MarshalMatcher and UnmarshalMatcher to
+// support matchers whose dynamic type is exactly t, under the supplied name.
+//
+// encode must return the arguments for the supplied matcher, which must be
+// nested matchers, nil, or values with an underlying bool, integer, floating
+// point, or string type. decode must build a matcher from such arguments, as
+// decoded by UnmarshalMatcher, returning an error if they are unacceptable.
+// decode may panic, in which case UnmarshalMatcher returns an error.
+//
+// RegisterMatcherCodec is safe to call concurrently, but it is typically
+// called from an init function. A later registration for the same name or
+// type replaces an earlier one.
+func RegisterMatcherCodec(
+	name string,
+	t reflect.Type,
+	encode func(m Matcher) (args []interface{}, err error),
+	decode func(args []interface{}) (Matcher, error)) {
+	codecsMutex.Lock()
+	defer codecsMutex.Unlock()
+
+	registerCodecLocked(name, t, encode, decode)
+}
+
+type matcherEncoder struct {
+	name   string
+	encode func(Matcher) ([]interface{}, error)
+}
+
+var codecsMutex sync.RWMutex
+var matcherEncoders = make(map[reflect.Type]matcherEncoder)
+var matcherDecoders = make(map[string]func([]interface{}) (Matcher, error))
+
+func registerCodecLocked(
+	name string,
+	t reflect.Type,
+	encode func(Matcher) ([]interface{}, error),
+	decode func([]interface{}) (Matcher, error)) {
+	if t != nil {
+		matcherEncoders[t] = matcherEncoder{name, encode}
+	}
+
+	matcherDecoders[name] = decode
+}
+
+func lookUpEncoder(t reflect.Type) (e matcherEncoder, ok bool) {
+	codecsMutex.RLock()
+	defer codecsMutex.RUnlock()
+
+	e, ok = matcherEncoders[t]
+	return
+}
+
+func lookUpDecoder(name string) func([]interface{}) (Matcher, error) {
+	codecsMutex.RLock()
+	defer codecsMutex.RUnlock()
+
+	return matcherDecoders[name]
+}
+
+// The JSON form of a matcher.
+type matcherJSON struct {
+	Matcher string            `json:"matcher"`
+	Args    []json.RawMessage `json:"args"`
+}
+
+////////////////////////////////////////////////////////////////////////
+// Encoding
+////////////////////////////////////////////////////////////////////////
+
+// Implemented by built-in matchers whose type is shared by several
+// constructors, returning the name of the constructor that created the
+// matcher along with the arguments to encode.
+type specMatcher interface {
+	Matcher
+	spec() (name string, args []interface{})
+}
+
+func encodeMatcher(m Matcher) ([]byte, error) {
+	var name string
+	var args []interface{}
+
+	switch m := m.(type) {
+	case nil:
+		return nil, errors.New("nil matcher")
+
+	case specMatcher:
+		name, args = m.spec()
+
+	case *predicateMatcher:
+		if m.name != "" {
+			return nil, fmt.Errorf("%s matchers cannot be serialized, since they depend on Go types", m.name)
+		}
+
+		return nil, fmt.Errorf("matchers created with NewMatcher cannot be serialized (%s)", m.Description())
+
+	case *panicsMatcher:
+		return nil, errors.New("Panics matchers cannot be serialized")
+
+	case *resultOfMatcher:
+		return nil, errors.New("ResultOf matchers cannot be serialized, since they contain functions")
+
+	case *identicalToMatcher:
+		return nil, errors.New("IdenticalTo matchers cannot be serialized, since they depend on identity")
+
+	case *dynamicTypeMatcher:
+		return nil, errors.New("WhenDynamicTypeIs matchers cannot be serialized, since they depend on Go types")
+
+	case *contextValueMatcher:
+		return nil, errors.New("ContextValue matchers cannot be serialized, since their keys are Go values")
+
+	case *errorIsMatcher:
+		return nil, fmt.Errorf("error values cannot be serialized (%s)", m.Description())
+
+	default:
+		e, ok := lookUpEncoder(reflect.TypeOf(m))
+		if !ok {
+			return nil, fmt.Errorf("matchers of type %T cannot be serialized (%s)", m, m.Description())
+		}
+
+		var err error
+		if args, err = e.encode(m); err != nil {
+			return nil, fmt.Errorf("%s: %v", e.name, err)
+		}
+
+		name = e.name
+	}
+
+	j := matcherJSON{Matcher: name, Args: make([]json.RawMessage, len(args))}
+	for i, arg := range args {
+		raw, err := encodeArg(arg)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+
+		j.Args[i] = raw
+	}
+
+	return json.Marshal(j)
+}
+
+func encodeArg(arg interface{}) ([]byte, error) {
+	if m, ok := arg.(Matcher); ok {
+		return encodeMatcher(m)
+	}
+
+	v := reflect.ValueOf(arg)
+	switch {
+	case !v.IsValid():
+		return []byte("null"), nil
+
+	case v.Kind() == reflect.Bool:
+		return json.Marshal(v.Bool())
+
+	case isSignedInteger(v):
+		return []byte(strconv.FormatInt(v.Int(), 10)), nil
+
+	case isUnsignedInteger(v):
+		return []byte(strconv.FormatUint(v.Uint(), 10)), nil
+
+	case isFloat(v):
+		f := v.Float()
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, fmt.Errorf("%v cannot be serialized", f)
+		}
+
+		// Make sure that the number is decoded as a float.
+		s := strconv.FormatFloat(f, 'g', -1, v.Type().Bits())
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+
+		return []byte(s), nil
+
+	case v.Kind() == reflect.String:
+		return json.Marshal(v.String())
+	}
+
+	return nil, fmt.Errorf("arguments of type %T cannot be serialized", arg)
+}
+
+////////////////////////////////////////////////////////////////////////
+// Decoding
+////////////////////////////////////////////////////////////////////////
+
+func decodeMatcher(data []byte) (m Matcher, err error) {
+	var j matcherJSON
+	if err = json.Unmarshal(data, &j); err != nil {
+		return
+	}
+
+	if j.Matcher == "" {
+		err = fmt.Errorf("missing matcher name in %s", data)
+		return
+	}
+
+	decode := lookUpDecoder(j.Matcher)
+	if decode == nil {
+		err = fmt.Errorf("unknown matcher %q", j.Matcher)
+		return
+	}
+
+	args := make([]interface{}, len(j.Args))
+	for i, raw := range j.Args {
+		if args[i], err = decodeArg(raw); err != nil {
+			err = fmt.Errorf("%s: %v", j.Matcher, err)
+			return
+		}
+	}
+
+	// Constructors panic when given bad arguments.
+	defer func() {
+		if r := recover(); r != nil {
+			m = nil
+			err = fmt.Errorf("%s: %v", j.Matcher, r)
+		}
+	}()
+
+	if m, err = decode(args); err != nil {
+		err = fmt.Errorf("%s: %v", j.Matcher, err)
+	}
+
+	return
+}
+
+func decodeArg(raw json.RawMessage) (interface{}, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil, errors.New("empty argument")
+	}
+
+	switch raw[0] {
+	case '{':
+		return decodeMatcher(raw)
+
+	case '[':
+		return nil, fmt.Errorf("unexpected array argument %s", raw)
+	}
+
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+
+	var x interface{}
+	if err := d.Decode(&x); err != nil {
+		return nil, err
+	}
+
+	n, ok := x.(json.Number)
+	if !ok {
+		return x, nil
+	}
+
+	s := n.String()
+	if !strings.ContainsAny(s, ".eE") {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			if int64(int(i)) == i {
+				return int(i), nil
+			}
+
+			return i, nil
+		}
+
+		if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return u, nil
+		}
+	}
+
+	return n.Float64()
+}
+
+func checkArgCount(args []interface{}, n int) error {
+	if len(args) != n {
+		return fmt.Errorf("expected %d argument(s), got %d", n, len(args))
+	}
+
+	return nil
+}
+
+func matcherArg(args []interface{}, i int) (Matcher, error) {
+	m, ok := args[i].(Matcher)
+	if !ok {
+		return nil, fmt.Errorf("argument %d is not a matcher", i)
+	}
+
+	return m, nil
+}
+
+func stringArg(args []interface{}, i int) (string, error) {
+	s, ok := args[i].(string)
+	if !ok {
+		return "", fmt.Errorf("argument %d is not a string", i)
+	}
+
+	return s, nil
+}
+
+func matcherArgs(args []interface{}) ([]Matcher, error) {
+	ms := make([]Matcher, len(args))
+	for i := range args {
+		var err error
+		if ms[i], err = matcherArg(args, i); err != nil {
+			return nil, err
+		}
+	}
+
+	return ms, nil
+}
+
+func matchersToArgs(ms []Matcher) []interface{} {
+	args := make([]interface{}, len(ms))
+	for i, m := range ms {
+		args[i] = m
+	}
+
+	return args
+}
+
+////////////////////////////////////////////////////////////////////////
+// Built-in codecs
+////////////////////////////////////////////////////////////////////////
+
+// Codecs for constructors taking no arguments.
+func registerNullaryCodec(name string, t reflect.Type, ctor func() Matcher) {
+	registerCodecLocked(
+		name,
+		t,
+		func(m Matcher) ([]interface{}, error) { return nil, nil },
+		func(args []interface{}) (Matcher, error) {
+			if err := checkArgCount(args, 0); err != nil {
+				return nil, err
+			}
+
+			return ctor(), nil
+		})
+}
+
+// Codecs for constructors taking a single value.
+func registerValueCodec(
+	name string,
+	t reflect.Type,
+	get func(Matcher) (interface{}, error),
+	ctor func(interface{}) Matcher) {
+	registerCodecLocked(
+		name,
+		t,
+		func(m Matcher) ([]interface{}, error) {
+			x, err := get(m)
+			return []interface{}{x}, err
+		},
+		func(args []interface{}) (Matcher, error) {
+			if err := checkArgCount(args, 1); err != nil {
+				return nil, err
+			}
+
+			return ctor(args[0]), nil
+		})
+}
+
+// Codecs for constructors taking a single matcher.
+func registerWrapperCodec(
+	name string,
+	t reflect.Type,
+	get func(Matcher) Matcher,
+	ctor func(Matcher) Matcher) {
+	registerCodecLocked(
+		name,
+		t,
+		func(m Matcher) ([]interface{}, error) {
+			return []interface{}{get(m)}, nil
+		},
+		func(args []interface{}) (Matcher, error) {
+			if err := checkArgCount(args, 1); err != nil {
+				return nil, err
+			}
+
+			wrapped, err := matcherArg(args, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			return ctor(wrapped), nil
+		})
+}
+
+// Codecs for constructors taking a list of matchers.
+func registerListCodec(
+	name string,
+	t reflect.Type,
+	get func(Matcher) []Matcher,
+	ctor func(...interface{}) Matcher) {
+	registerCodecLocked(
+		name,
+		t,
+		func(m Matcher) ([]interface{}, error) {
+			return matchersToArgs(get(m)), nil
+		},
+		func(args []interface{}) (Matcher, error) {
+			if _, err := matcherArgs(args); err != nil {
+				return nil, err
+			}
+
+			return ctor(args...), nil
+		})
+}
+
+// Codecs for constructors taking a string and a matcher.
+func registerNamedCodec(
+	name string,
+	t reflect.Type,
+	get func(Matcher) (string, Matcher),
+	ctor func(string, interface{}) Matcher) {
+	registerCodecLocked(
+		name,
+		t,
+		func(m Matcher) ([]interface{}, error) {
+			s, wrapped := get(m)
+			return []interface{}{s, wrapped}, nil
+		},
+		func(args []interface{}) (Matcher, error) {
+			if err := checkArgCount(args, 2); err != nil {
+				return nil, err
+			}
+
+			s, err := stringArg(args, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			wrapped, err := matcherArg(args, 1)
+			if err != nil {
+				return nil, err
+			}
+
+			return ctor(s, wrapped), nil
+		})
+}
+
+func init() {
+	codecsMutex.Lock()
+	defer codecsMutex.Unlock()
+
+	registerNullaryCodec("Any", reflect.TypeOf(&anyMatcher{}), Any)
+
+	registerValueCodec(
+		"Equals",
+		reflect.TypeOf(&equalsMatcher{}),
+		func(m Matcher) (interface{}, error) {
+			v := m.(*equalsMatcher).expectedValue
+			if !v.IsValid() {
+				return nil, nil
+			}
+
+			return v.Interface(), nil
+		},
+		Equals)
+
+	registerValueCodec(
+		"DeepEquals",
+		reflect.TypeOf(&deepEqualsMatcher{}),
+		func(m Matcher) (interface{}, error) {
+			// Only types that survive the round trip are supported, since
+			// DeepEquals is sensitive to them.
+			x := m.(*deepEqualsMatcher).x
+			switch x.(type) {
+			case nil, bool, int, float64, string:
+				return x, nil
+			}
+
+			return nil, fmt.Errorf("values of type %T cannot be serialized", x)
+		},
+		DeepEquals)
+
+	registerValueCodec(
+		"LessThan",
+		reflect.TypeOf(&lessThanMatcher{}),
+		func(m Matcher) (interface{}, error) {
+			return m.(*lessThanMatcher).limit.Interface(), nil
+		},
+		LessThan)
+
+	// GreaterThan, GreaterOrEqual, and LessOrEqual share a type, and are
+	// encoded by its spec method.
+	registerValueCodec("GreaterThan", nil, nil, GreaterThan)
+	registerValueCodec("GreaterOrEqual", nil, nil, GreaterOrEqual)
+	registerValueCodec("LessOrEqual", nil, nil, LessOrEqual)
+
+	registerCodecLocked(
+		"HasSubstr",
+		reflect.TypeOf(&hasSubstrMatcher{}),
+		func(m Matcher) ([]interface{}, error) {
+			return []interface{}{m.(*hasSubstrMatcher).needle}, nil
+		},
+		func(args []interface{}) (Matcher, error) {
+			if err := checkArgCount(args, 1); err != nil {
+				return nil, err
+			}
+
+			s, err := stringArg(args, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			return HasSubstr(s), nil
+		})
+
+	registerCodecLocked(
+		"MatchesRegexp",
+		reflect.TypeOf(&matchesRegexpMatcher{}),
+		func(m Matcher) ([]interface{}, error) {
+			return []interface{}{m.(*matchesRegexpMatcher).re.String()}, nil
+		},
+		func(args []interface{}) (Matcher, error) {
+			if err := checkArgCount(args, 1); err != nil {
+				return nil, err
+			}
+
+			pattern, err := stringArg(args, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			return MatchesRegexp(pattern), nil
+		})
+
+	registerWrapperCodec(
+		"Not",
+		reflect.TypeOf(&notMatcher{}),
+		func(m Matcher) Matcher { return m.(*notMatcher).wrapped },
+		Not)
+
+	registerWrapperCodec(
+		"Error",
+		reflect.TypeOf(&errorMatcher{}),
+		func(m Matcher) Matcher { return m.(*errorMatcher).wrappedMatcher },
+		Error)
+
+	registerWrapperCodec(
+		"Pointee",
+		reflect.TypeOf(&pointeeMatcher{}),
+		func(m Matcher) Matcher { return m.(*pointeeMatcher).wrapped },
+		Pointee)
+
+	registerWrapperCodec(
+		"Contains",
+		reflect.TypeOf(&containsMatcher{}),
+		func(m Matcher) Matcher { return m.(*containsMatcher).elementMatcher },
+		func(m Matcher) Matcher { return Contains(m) })
+
+	registerWrapperCodec(
+		"Each",
+		reflect.TypeOf(&eachMatcher{}),
+		func(m Matcher) Matcher { return m.(*eachMatcher).elementMatcher },
+		func(m Matcher) Matcher { return Each(m) })
+
+	registerWrapperCodec(
+		"None",
+		reflect.TypeOf(&noneMatcher{}),
+		func(m Matcher) Matcher { return m.(*noneMatcher).elementMatcher },
+		func(m Matcher) Matcher { return None(m) })
+
+	registerCodecLocked(
+		"ContainsCount",
+		reflect.TypeOf(&containsCountMatcher{}),
+		func(m Matcher) ([]interface{}, error) {
+			cm := m.(*containsCountMatcher)
+			return []interface{}{cm.elementMatcher, cm.countMatcher}, nil
+		},
+		func(args []interface{}) (Matcher, error) {
+			if err := checkArgCount(args, 2); err != nil {
+				return nil, err
+			}
+
+			if _, err := matcherArgs(args); err != nil {
+				return nil, err
+			}
+
+			return ContainsCount(args[0], args[1]), nil
+		})
+
+	registerListCodec(
+		"AllOf",
+		reflect.TypeOf(&allOfMatcher{}),
+		func(m Matcher) []Matcher { return m.(*allOfMatcher).wrappedMatchers },
+		func(args ...interface{}) Matcher { return AllOf(toMatchers(args)...) })
+
+	registerListCodec(
+		"AnyOf",
+		reflect.TypeOf(&anyOfMatcher{}),
+		func(m Matcher) []Matcher { return m.(*anyOfMatcher).wrapped },
+		AnyOf)
+
+	registerListCodec(
+		"ElementsAre",
+		reflect.TypeOf(&elementsAreMatcher{}),
+		func(m Matcher) []Matcher { return m.(*elementsAreMatcher).subMatchers },
+		ElementsAre)
+
+	registerListCodec(
+		"IsSupersetOf",
+		reflect.TypeOf(&isSupersetOfMatcher{}),
+		func(m Matcher) []Matcher { return m.(*isSupersetOfMatcher).subMatchers },
+		IsSupersetOf)
+
+	registerListCodec(
+		"IsSubsetOf",
+		reflect.TypeOf(&isSubsetOfMatcher{}),
+		func(m Matcher) []Matcher { return m.(*isSubsetOfMatcher).subMatchers },
+		IsSubsetOf)
+
+	registerNamedCodec(
+		"Property",
+		reflect.TypeOf(&propertyMatcher{}),
+		func(m Matcher) (string, Matcher) {
+			pm := m.(*propertyMatcher)
+			return pm.name, pm.wrapped
+		},
+		Property)
+
+	registerNamedCodec(
+		"At",
+		reflect.TypeOf(&atMatcher{}),
+		func(m Matcher) (string, Matcher) {
+			am := m.(*atMatcher)
+			return am.path, am.wrapped
+		},
+		At)
+
+	registerWrapperCodec(
+		"ReaderContents",
+		reflect.TypeOf(&readerContentsMatcher{}),
+		func(m Matcher) Matcher { return m.(*readerContentsMatcher).wrapped },
+		func(m Matcher) Matcher { return ReaderContents(m) })
+
+	// File matchers. FileMode and FileSize share a type, and are encoded by
+	// its spec method.
+	registerNullaryCodec("FileExists", reflect.TypeOf(&fileExistsMatcher{}), FileExists)
+	registerNullaryCodec("IsDir", reflect.TypeOf(&isDirMatcher{}), IsDir)
+
+	registerWrapperCodec("FileMode", nil, nil, func(m Matcher) Matcher { return FileMode(m) })
+	registerWrapperCodec("FileSize", nil, nil, func(m Matcher) Matcher { return FileSize(m) })
+
+	registerWrapperCodec(
+		"FileContents",
+		reflect.TypeOf(&fileContentsMatcher{}),
+		func(m Matcher) Matcher { return m.(*fileContentsMatcher).wrapped },
+		func(m Matcher) Matcher { return FileContents(m) })
+
+	// HTTP response matchers.
+	registerWrapperCodec(
+		"HTTPStatus",
+		reflect.TypeOf(&httpStatusMatcher{}),
+		func(m Matcher) Matcher { return m.(*httpStatusMatcher).wrapped },
+		func(m Matcher) Matcher { return HTTPStatus(m) })
+
+	registerNamedCodec(
+		"HTTPHeader",
+		reflect.TypeOf(&httpHeaderMatcher{}),
+		func(m Matcher) (string, Matcher) {
+			hm := m.(*httpHeaderMatcher)
+			return hm.name, hm.wrapped
+		},
+		HTTPHeader)
+
+	registerWrapperCodec(
+		"HTTPBody",
+		reflect.TypeOf(&httpBodyMatcher{}),
+		func(m Matcher) Matcher { return m.(*httpBodyMatcher).wrapped },
+		func(m Matcher) Matcher { return HTTPBody(m) })
+
+	registerWrapperCodec(
+		"HTTPJSONBody",
+		reflect.TypeOf(&httpJSONBodyMatcher{}),
+		func(m Matcher) Matcher { return m.(*httpJSONBodyMatcher).wrapped },
+		func(m Matcher) Matcher { return HTTPJSONBody(m) })
+
+	// HTTP request matchers. All but RequestBody share a type, and are encoded
+	// by its spec method.
+	registerWrapperCodec("RequestMethod", nil, nil, func(m Matcher) Matcher { return RequestMethod(m) })
+	registerWrapperCodec("RequestURL", nil, nil, func(m Matcher) Matcher { return RequestURL(m) })
+	registerWrapperCodec("RequestPath", nil, nil, func(m Matcher) Matcher { return RequestPath(m) })
+	registerNamedCodec("RequestQuery", nil, nil, RequestQuery)
+	registerNamedCodec("RequestHeader", nil, nil, RequestHeader)
+
+	registerWrapperCodec(
+		"RequestBody",
+		reflect.TypeOf(&requestBodyMatcher{}),
+		func(m Matcher) Matcher { return m.(*requestBodyMatcher).wrapped },
+		func(m Matcher) Matcher { return RequestBody(m) })
+
+	// Context matchers.
+	registerNullaryCodec("ContextDone", reflect.TypeOf(&contextDoneMatcher{}), ContextDone)
+
+	registerWrapperCodec(
+		"ContextHasDeadline",
+		reflect.TypeOf(&contextDeadlineMatcher{}),
+		func(m Matcher) Matcher { return m.(*contextDeadlineMatcher).wrapped },
+		func(m Matcher) Matcher { return ContextHasDeadline(m) })
+
+	registerWrapperCodec(
+		"ContextErr",
+		reflect.TypeOf(&contextErrMatcher{}),
+		func(m Matcher) Matcher { return m.(*contextErrMatcher).wrapped },
+		func(m Matcher) Matcher { return ContextErr(m) })
+
+	// The "len" and "contains" expressions of ParseMatcher, which have no
+	// constructors of their own.
+	registerWrapperCodec(
+		"len",
+		reflect.TypeOf(&lengthMatcher{}),
+		func(m Matcher) Matcher { return m.(*lengthMatcher).wrapped },
+		func(m Matcher) Matcher { return &lengthMatcher{m} })
+
+	registerCodecLocked(
+		"contains",
+		reflect.TypeOf(&containsExprMatcher{}),
+		func(m Matcher) ([]interface{}, error) {
+			return []interface{}{m.(*containsExprMatcher).operand}, nil
+		},
+		func(args []interface{}) (Matcher, error) {
+			if err := checkArgCount(args, 1); err != nil {
+				return nil, err
+			}
+
+			switch args[0].(type) {
+			case Matcher, string:
+				return newContainsExprMatcher(args[0]), nil
+			}
+
+			return nil, errors.New("argument 0 is neither a matcher nor a string")
+		})
+}
@@ -0,0 +1,340 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing/fstest"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type MarshalMatcherTest struct {
+}
+
+func init() { RegisterTestSuite(&MarshalMatcherTest{}) }
+
+// A custom matcher that takes part in serialization.
+type hasPrefixMatcher struct {
+	prefix string
+}
+
+func (m *hasPrefixMatcher) Description() string {
+	return fmt.Sprintf("has prefix %q", m.prefix)
+}
+
+func (m *hasPrefixMatcher) Matches(c interface{}) error {
+	s, ok := c.(string)
+	if !ok {
+		return NewFatalError("which is not a string")
+	}
+
+	if !strings.HasPrefix(s, m.prefix) {
+		return errors.New("")
+	}
+
+	return nil
+}
+
+func init() {
+	RegisterMatcherCodec(
+		"HasPrefix",
+		reflect.TypeOf(&hasPrefixMatcher{}),
+		func(m Matcher) ([]interface{}, error) {
+			return []interface{}{m.(*hasPrefixMatcher).prefix}, nil
+		},
+		func(args []interface{}) (Matcher, error) {
+			if len(args) != 1 {
+				return nil, errors.New("expected one argument")
+			}
+
+			prefix, ok := args[0].(string)
+			if !ok {
+				return nil, errors.New("expected a string")
+			}
+
+			return &hasPrefixMatcher{prefix}, nil
+		})
+}
+
+// Marshal the matcher, unmarshal the result, and check that the descriptions
+// agree. Return the JSON.
+func roundTrip(m Matcher) string {
+	data, err := MarshalMatcher(m)
+	AssertEq(nil, err)
+
+	decoded, err := UnmarshalMatcher(data)
+	AssertEq(nil, err, "JSON: %s", data)
+	ExpectEq(m.Description(), decoded.Description(), "JSON: %s", data)
+
+	return string(data)
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *MarshalMatcherTest) Encoding() {
+	data, err := MarshalMatcher(AllOf(GreaterOrEqual(2), LessThan(10)))
+	AssertEq(nil, err)
+	ExpectEq(
+		`{"matcher":"AllOf","args":[`+
+			`{"matcher":"GreaterOrEqual","args":[2]},`+
+			`{"matcher":"LessThan","args":[10]}]}`,
+		string(data))
+
+	data, err = MarshalMatcher(Contains("taco"))
+	AssertEq(nil, err)
+	ExpectEq(`{"matcher":"Contains","args":[{"matcher":"Equals","args":["taco"]}]}`, string(data))
+
+	data, err = MarshalMatcher(Any())
+	AssertEq(nil, err)
+	ExpectEq(`{"matcher":"Any","args":[]}`, string(data))
+}
+
+func (t *MarshalMatcherTest) ScalarArguments() {
+	type stringAlias string
+
+	ExpectEq(`{"matcher":"Equals","args":[null]}`, roundTrip(Equals(nil)))
+	ExpectEq(`{"matcher":"Equals","args":[true]}`, roundTrip(Equals(true)))
+	ExpectEq(`{"matcher":"Equals","args":[-17]}`, roundTrip(Equals(int8(-17))))
+	ExpectEq(`{"matcher":"Equals","args":[18446744073709551615]}`, roundTrip(Equals(uint64(1<<64-1))))
+	ExpectEq(`{"matcher":"Equals","args":[17.5]}`, roundTrip(Equals(17.5)))
+	ExpectEq(`{"matcher":"Equals","args":["taco"]}`, roundTrip(Equals(stringAlias("taco"))))
+
+	// Integral floats remain floats.
+	ExpectEq(`{"matcher":"DeepEquals","args":[17.0]}`, roundTrip(DeepEquals(17.0)))
+	ExpectEq(`{"matcher":"DeepEquals","args":[17]}`, roundTrip(DeepEquals(17)))
+}
+
+func (t *MarshalMatcherTest) DecodedMatchersWork() {
+	data := `{"matcher": "AnyOf", "args": [
+		{"matcher": "MatchesRegexp", "args": ["^ta"]},
+		{"matcher": "DeepEquals", "args": [17]}
+	]}`
+
+	m, err := UnmarshalMatcher([]byte(data))
+	AssertEq(nil, err)
+
+	ExpectEq(nil, m.Matches("taco"))
+	ExpectEq(nil, m.Matches(17))
+	ExpectNe(nil, m.Matches("burrito"))
+	ExpectNe(nil, m.Matches(int64(17)))
+}
+
+func (t *MarshalMatcherTest) BuiltInMatchers() {
+	matchers := []Matcher{
+		Any(),
+		Equals("taco"),
+		DeepEquals("taco"),
+		LessThan(17),
+		LessOrEqual(17.5),
+		GreaterThan("taco"),
+		GreaterOrEqual(uint(17)),
+		HasSubstr("ac"),
+		MatchesRegexp(`^\d+$`),
+		Not(Equals(17)),
+		Error(HasSubstr("taco")),
+		Pointee(Equals(17)),
+		Contains(LessThan(17)),
+		ContainsCount("taco", 2),
+		ContainsAtLeast("taco", 2),
+		Each(GreaterThan(0)),
+		None("taco"),
+		AllOf(),
+		AllOf(LessThan(19), Not(Equals(17))),
+		AnyOf(17, "taco", nil),
+		ElementsAre(17, Any()),
+		IsSupersetOf("taco"),
+		IsSubsetOf("taco", "burrito"),
+		Property("Name", HasSubstr("ac")),
+		At(`users[0]."first name"`, "taco"),
+		ReaderContents(HasSubstr("ac")),
+		FileExists(),
+		IsDir(),
+		FileMode(0644),
+		FileSize(GreaterThan(0)),
+		FileContents("taco"),
+		HTTPStatus(200),
+		HTTPHeader("content-type", HasSubstr("json")),
+		HTTPBody("taco"),
+		HTTPJSONBody(At("name", "taco")),
+		RequestMethod("GET"),
+		RequestURL(HasSubstr("taco")),
+		RequestPath("/taco"),
+		RequestQuery("q", "taco"),
+		RequestHeader("accept", "text/plain"),
+		RequestBody(HasSubstr("taco")),
+		ContextDone(),
+		ContextHasDeadline(GreaterThan(0)),
+		ContextErr(Not(Equals(nil))),
+	}
+
+	for _, m := range matchers {
+		roundTrip(m)
+	}
+}
+
+func (t *MarshalMatcherTest) ParsedMatchers() {
+	m, err := ParseMatcher(`len == 3 && contains "taco"`)
+	AssertEq(nil, err)
+	ExpectEq(
+		`{"matcher":"AllOf","args":[`+
+			`{"matcher":"len","args":[{"matcher":"Equals","args":[3]}]},`+
+			`{"matcher":"contains","args":["taco"]}]}`,
+		roundTrip(m))
+
+	m, err = ParseMatcher(`contains > 2`)
+	AssertEq(nil, err)
+	ExpectEq(
+		`{"matcher":"contains","args":[{"matcher":"GreaterThan","args":[2]}]}`,
+		roundTrip(m))
+
+	// Decoded "contains" matchers still accept substrings.
+	decoded, err := UnmarshalMatcher([]byte(`{"matcher":"contains","args":["ac"]}`))
+	AssertEq(nil, err)
+	ExpectEq(nil, decoded.Matches("taco"))
+	ExpectEq(nil, decoded.Matches([]string{"ac"}))
+	ExpectNe(nil, decoded.Matches([]string{"taco"}))
+}
+
+func (t *MarshalMatcherTest) CustomMatchers() {
+	m := AllOf(&hasPrefixMatcher{"ta"}, Not(Equals("taco")))
+	ExpectEq(
+		`{"matcher":"AllOf","args":[`+
+			`{"matcher":"HasPrefix","args":["ta"]},`+
+			`{"matcher":"Not","args":[{"matcher":"Equals","args":["taco"]}]}]}`,
+		roundTrip(m))
+
+	_, err := UnmarshalMatcher([]byte(`{"matcher":"HasPrefix","args":[17]}`))
+	ExpectThat(err, Error(Equals("UnmarshalMatcher: HasPrefix: expected a string")))
+}
+
+func (t *MarshalMatcherTest) UnserializableMatchers() {
+	cases := []struct {
+		m   Matcher
+		err string
+	}{
+		{
+			NewMatcher(func(interface{}) error { return nil }, "is fine"),
+			"matchers created with NewMatcher cannot be serialized (is fine)",
+		},
+		{
+			Panics(Any()),
+			"Panics matchers cannot be serialized",
+		},
+		{
+			ResultOf("length", func(s string) int { return len(s) }, 4),
+			"ResultOf matchers cannot be serialized, since they contain functions",
+		},
+		{
+			IdenticalTo(new(int)),
+			"IdenticalTo matchers cannot be serialized, since they depend on identity",
+		},
+		{
+			HasSameTypeAs(17),
+			"HasSameTypeAs matchers cannot be serialized, since they depend on Go types",
+		},
+		{
+			Implements((*error)(nil)),
+			"Implements matchers cannot be serialized, since they depend on Go types",
+		},
+		{
+			KindIs(reflect.Int),
+			"KindIs matchers cannot be serialized, since they depend on Go types",
+		},
+		{
+			Not(TypeMatches("int")),
+			"Not: TypeMatches matchers cannot be serialized, since they depend on Go types",
+		},
+		{
+			WhenDynamicTypeIs(17, Any()),
+			"WhenDynamicTypeIs matchers cannot be serialized, since they depend on Go types",
+		},
+		{
+			ContextValue("key", "taco"),
+			"ContextValue matchers cannot be serialized, since their keys are Go values",
+		},
+		{
+			ContextErr(context.Canceled),
+			"ContextErr: error values cannot be serialized (is or wraps error: context canceled)",
+		},
+		{
+			Capture(new(int), Any()),
+			"matchers of type *oglematchers.captureMatcher cannot be serialized (is anything)",
+		},
+		{
+			FSEquals(fstest.MapFS{}),
+			"matchers of type *oglematchers.fsMatcher cannot be serialized (file tree equals: [])",
+		},
+		{
+			Not(AllOf(Equals(17), Panics(Any()))),
+			"Not: AllOf: Panics matchers cannot be serialized",
+		},
+		{
+			DeepEquals([]int{17}),
+			"DeepEquals: values of type []int cannot be serialized",
+		},
+		{
+			DeepEquals(int64(17)),
+			"DeepEquals: values of type int64 cannot be serialized",
+		},
+		{
+			Equals(complex(1, 2)),
+			"Equals: arguments of type complex128 cannot be serialized",
+		},
+	}
+
+	for _, c := range cases {
+		data, err := MarshalMatcher(c.m)
+		ExpectEq(nil, data)
+		ExpectThat(err, Error(Equals("MarshalMatcher: "+c.err)))
+	}
+}
+
+func (t *MarshalMatcherTest) InvalidJSON() {
+	cases := []struct {
+		data string
+		err  string
+	}{
+		{`{"matcher":"Taco","args":[]}`, `unknown matcher "Taco"`},
+		{`{"args":[]}`, `missing matcher name in {"args":[]}`},
+		{`{"matcher":"Equals","args":[]}`, `Equals: expected 1 argument(s), got 0`},
+		{`{"matcher":"Not","args":[17]}`, `Not: argument 0 is not a matcher`},
+		{`{"matcher":"Equals","args":[[17]]}`, `Equals: unexpected array argument [17]`},
+		{`{"matcher":"LessThan","args":[true]}`, `LessThan: LessThan: unexpected kind bool`},
+		{`{"matcher":"Not","args":[{"matcher":"Taco"}]}`, `Not: unknown matcher "Taco"`},
+		{`{"matcher":"MatchesRegexp","args":["("]}`, "MatchesRegexp: MatchesRegexp: error parsing regexp: missing closing ): `(`"},
+	}
+
+	for _, c := range cases {
+		m, err := UnmarshalMatcher([]byte(c.data))
+		ExpectEq(nil, m, "data: %s", c.data)
+		ExpectThat(err, Error(Equals("UnmarshalMatcher: "+c.err)), "data: %s", c.data)
+	}
+
+	_, err := UnmarshalMatcher([]byte(`{`))
+	ExpectThat(err, Error(HasSubstr("unexpected end of JSON input")))
+}
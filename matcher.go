@@ -20,8 +20,30 @@
 // This package is used by github.com/jacobsa/ogletest and
 // github.com/jacobsa/oglemock, which may be more directly useful if you're not
 // writing your own testing package or defining your own matchers.
+//
+// The collection matchers (Contains, ContainsCount, Each, None, ElementsAre,
+// IsSupersetOf, and IsSubsetOf) accept the following candidates, in addition
+// to arrays and slices:
+//
+//  *  Range-over-func iterators, i.e. functions of the form of iter.Seq and
+//     iter.Seq2. The elements of an iter.Seq2 are its values, with keys
+//     appearing only in error text, as for maps.
+//
+//  *  Receive channels, which are read until they are closed. Matching a
+//     channel that is never closed blocks forever.
+//
+//  *  Values of type *list.List.
+//
+// Iterators and channels are consumed as they are examined, and matchers stop
+// consuming them as soon as the result is known; for example Contains stops at
+// the first matching element. Failure messages for these candidates say how
+// many elements were consumed.
 package oglematchers
 
+import (
+	"errors"
+)
+
 // A Matcher is some predicate implicitly defining a set of values that it
 // matches. For example, GreaterThan(17) matches all numeric values greater
 // than 17, and HasSubstr("taco") matches all strings with the substring
@@ -62,6 +84,17 @@ type Matcher interface {
 	Description() string
 }
 
+// NegationDescriber is an optional interface that may be implemented by
+// matchers in order to give a more natural description of the values they
+// don't match. It is consulted by Not; matchers that don't implement it are
+// described by Not as "not(<description>)".
+type NegationDescriber interface {
+	// DescribeNegation returns a string describing the property that values
+	// not matching the matcher have, in the same form as Description. For
+	// example, "is not greater than 17" or "doesn't have substring "taco"".
+	DescribeNegation() string
+}
+
 // FatalError is an implementation of the error interface that may be returned
 // from matchers, indicating the error should be propagated. Returning a
 // *FatalError indicates that the matcher doesn't process values of the
@@ -84,3 +117,15 @@ func NewFatalError(s string) *FatalError {
 func (e *FatalError) Error() string {
 	return e.errorText
 }
+
+// Errors shared by the matchers in this package, so that the common cases of
+// failing to match or being given the wrong type of candidate don't allocate.
+// Errors are never modified once created, so sharing them is safe.
+var (
+	errNoMatch = errors.New("")
+
+	errNotBool       = NewFatalError("which is not a bool")
+	errNotComparable = NewFatalError("which is not comparable")
+	errNotNumeric    = NewFatalError("which is not numeric")
+	errNotString     = NewFatalError("which is not a string")
+)
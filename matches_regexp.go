@@ -16,7 +16,6 @@
 package oglematchers
 
 import (
-	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -40,15 +39,25 @@ type matchesRegexpMatcher struct {
 }
 
 func (m *matchesRegexpMatcher) Description() string {
-	return fmt.Sprintf("matches regexp \"%s\"", m.re.String())
+	return fmt.Sprintf("matches regexp %s", FormatValue(m.re.String()))
 }
 
-func (m *matchesRegexpMatcher) Matches(c interface{}) (err error) {
+func (m *matchesRegexpMatcher) DescribeNegation() string {
+	return fmt.Sprintf("doesn't match regexp %s", FormatValue(m.re.String()))
+}
+
+func (m *matchesRegexpMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *matchesRegexpMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
 	v := reflect.ValueOf(c)
 	isString := v.Kind() == reflect.String
-	isByteSlice := v.Kind() == reflect.Slice && v.Elem().Kind() == reflect.Uint8
+	isByteSlice := v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8
 
-	err = errors.New("")
+	err = errNoMatch
 
 	switch {
 	case isString:
@@ -90,3 +90,19 @@ func (t *MatchesRegexpTest) MatchingCandidates() {
 	err = m.Matches("blah blah foo x blah blah")
 	ExpectEq(nil, err)
 }
+
+func (t *MatchesRegexpTest) ByteSliceCandidates() {
+	m := MatchesRegexp("fo[op]\\s+x")
+	var err error
+
+	err = m.Matches([]byte("blah foo x blah"))
+	ExpectEq(nil, err)
+
+	err = m.Matches([]byte("fon x"))
+	ExpectThat(err, Error(Equals("")))
+	ExpectFalse(isFatal(err))
+
+	err = m.Matches([]int{17})
+	ExpectThat(err, Error(Equals("which is not a string or []byte")))
+	ExpectTrue(isFatal(err))
+}
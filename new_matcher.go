@@ -15,6 +15,10 @@
 
 package oglematchers
 
+import (
+	"fmt"
+)
+
 // Create a matcher with the given description and predicate function, which
 // will be invoked to handle calls to Matchers.
 //
@@ -29,15 +33,49 @@ func NewMatcher(
 	}
 }
 
+// Like NewMatcher, but the matcher describes its negation using the supplied
+// string. The name is that of the built-in function creating the matcher.
+func newMatcherWithNegation(
+	name string,
+	predicate func(interface{}) error,
+	description string,
+	negDescription string) Matcher {
+	return &predicateMatcher{
+		name:           name,
+		predicate:      predicate,
+		description:    description,
+		negDescription: negDescription,
+	}
+}
+
 type predicateMatcher struct {
-	predicate   func(interface{}) error
-	description string
+	// The name of the built-in function that created the matcher, or the empty
+	// string for matchers created with NewMatcher.
+	name string
+
+	predicate      func(interface{}) error
+	description    string
+	negDescription string
 }
 
 func (pm *predicateMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, pm, c)
+}
+
+func (pm *predicateMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
 	return pm.predicate(c)
 }
 
 func (pm *predicateMatcher) Description() string {
 	return pm.description
 }
+
+func (pm *predicateMatcher) DescribeNegation() string {
+	if pm.negDescription == "" {
+		return fmt.Sprintf("not(%s)", pm.description)
+	}
+
+	return pm.negDescription
+}
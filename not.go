@@ -16,7 +16,6 @@
 package oglematchers
 
 import (
-	"errors"
 	"fmt"
 )
 
@@ -31,12 +30,18 @@ type notMatcher struct {
 	wrapped Matcher
 }
 
-func (m *notMatcher) Matches(c interface{}) (err error) {
-	err = m.wrapped.Matches(c)
+func (m *notMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *notMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	err = o.matches(m.wrapped, c)
 
 	// Did the wrapped matcher say yes?
 	if err == nil {
-		return errors.New("")
+		return errNoMatch
 	}
 
 	// Did the wrapped matcher return a fatal error?
@@ -49,5 +54,19 @@ func (m *notMatcher) Matches(c interface{}) (err error) {
 }
 
 func (m *notMatcher) Description() string {
-	return fmt.Sprintf("not(%s)", m.wrapped.Description())
+	return describeNegation(m.wrapped)
+}
+
+func (m *notMatcher) DescribeNegation() string {
+	return m.wrapped.Description()
+}
+
+// Return the negated description of the supplied matcher, falling back to a
+// generic form if it doesn't implement NegationDescriber.
+func describeNegation(m Matcher) string {
+	if nd, ok := m.(NegationDescriber); ok {
+		return nd.DescribeNegation()
+	}
+
+	return fmt.Sprintf("not(%s)", m.Description())
 }
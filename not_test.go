@@ -106,3 +106,78 @@ func (t *NotTest) Description() {
 
 	ExpectEq("not(taco)", matcher.Description())
 }
+
+type fakeNegationDescriber struct {
+	fakeMatcher
+	negDescription string
+}
+
+func (m *fakeNegationDescriber) DescribeNegation() string {
+	return m.negDescription
+}
+
+func (t *NotTest) DescriptionUsesNegationDescriber() {
+	wrapped := &fakeNegationDescriber{fakeMatcher{nil, "taco"}, "burrito"}
+	matcher := Not(wrapped)
+
+	ExpectEq("burrito", matcher.Description())
+}
+
+func (t *NotTest) DoubleNegation() {
+	wrapped := &fakeMatcher{nil, "taco"}
+	matcher := Not(Not(wrapped))
+
+	ExpectEq("taco", matcher.Description())
+}
+
+func (t *NotTest) BuiltInNegations() {
+	ExpectEq("is not nil", Not(Equals(nil)).Description())
+	ExpectEq("is not equal to 17", Not(Equals(17)).Description())
+	ExpectEq("is not equal to \"taco\"", Not(Equals("taco")).Description())
+	ExpectEq("is not less than 17", Not(LessThan(17)).Description())
+	ExpectEq("is not greater than 17", Not(GreaterThan(17)).Description())
+	ExpectEq("is not less than or equal to 17", Not(LessOrEqual(17)).Description())
+	ExpectEq("is not greater than or equal to 17", Not(GreaterOrEqual(17)).Description())
+	ExpectEq("doesn't have substring \"taco\"", Not(HasSubstr("taco")).Description())
+	ExpectEq("doesn't match regexp \"t.*o\"", Not(MatchesRegexp("t.*o")).Description())
+	ExpectEq("doesn't have type int", Not(HasSameTypeAs(17)).Description())
+	ExpectEq("not identical to <int> 17", Not(IdenticalTo(17)).Description())
+	ExpectEq("doesn't deep equal: [17]", Not(DeepEquals([]int{17})).Description())
+	ExpectEq("doesn't contain: 17", Not(Contains(17)).Description())
+	ExpectEq("is nothing", Not(Any()).Description())
+	ExpectEq("error doesn't have substring \"taco\"", Not(Error(HasSubstr("taco"))).Description())
+	ExpectEq("pointee(is not equal to 17)", Not(Pointee(Equals(17))).Description())
+}
+
+func (t *NotTest) CompositeNegations() {
+	ExpectEq(
+		"is not greater than 17, or is not less than 19",
+		Not(AllOf(GreaterThan(17), LessThan(19))).Description())
+	ExpectEq("is nothing", Not(AllOf()).Description())
+
+	ExpectEq(
+		"is not equal to 17, and is not equal to 19",
+		Not(AnyOf(17, 19)).Description())
+	ExpectEq("is anything", Not(AnyOf()).Description())
+
+	ExpectEq(
+		"doesn't have each element: greater than 17",
+		Not(Each(GreaterThan(17))).Description())
+	ExpectEq("has an element: greater than 17", Not(None(GreaterThan(17))).Description())
+
+	ExpectEq("elements aren't: [17, greater than 19]", Not(ElementsAre(17, GreaterThan(19))).Description())
+	ExpectEq("is not superset of: [17, 19]", Not(IsSupersetOf(17, 19)).Description())
+	ExpectEq("is not subset of: [17, 19]", Not(IsSubsetOf(17, 19)).Description())
+	ExpectEq("doesn't panic with: has substring \"taco\"", Not(Panics(HasSubstr("taco"))).Description())
+}
+
+func (t *NotTest) NestedNegations() {
+	ExpectEq("greater than 17", Not(Not(GreaterThan(17))).Description())
+	ExpectEq(
+		"pointee(is not greater than 17)",
+		Pointee(Not(GreaterThan(17))).Description())
+}
+
+func (t *NotTest) FallsBackForOtherMatchers() {
+	ExpectEq("not(taco)", Not(NewMatcher(nil, "taco")).Description())
+}
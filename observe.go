@@ -0,0 +1,302 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// A MatchEvent describes a completed call to the Matches method of one of the
+// matchers in this package.
+type MatchEvent struct {
+	Matcher     Matcher
+	Candidate   interface{}
+	Description string
+
+	// The result of the call.
+	Err error
+
+	// How long the call took, including any calls to sub-matchers.
+	Duration time.Duration
+
+	// The number of enclosing Matches calls being observed, i.e. zero for the
+	// call made by the test itself, one for the calls that makes to
+	// sub-matchers, and so on.
+	Depth int
+
+	// Identifies the top-level call that this one is part of. All events with
+	// the same Chain belong to a single tree of calls.
+	Chain uint64
+}
+
+// A MatchObserver is told about calls to the Matches methods of the matchers
+// in this package, after they return. Since a call completes only after the
+// calls it makes to sub-matchers, events arrive in post-order.
+//
+// Observers must not call matchers themselves.
+type MatchObserver func(e MatchEvent)
+
+// AddMatchObserver arranges for the supplied observer to be told about all
+// subsequent calls to the matchers in this package, and returns a function
+// that undoes this. To observe only part of a test:
+//
+//     defer AddMatchObserver(NewTracer(os.Stderr))()
+//
+// Each matcher passes its own call along to the sub-matchers it calls, so
+// matchers may be called from several goroutines at once; events from
+// concurrent calls may be interleaved, but can be told apart by their Chain
+// fields. Matchers called from other code, such as the function passed to
+// NewMatcher or a matcher defined outside this package, are reported as
+// top-level calls.
+// Comparison matchers such as GreaterThan are reported as a single call,
+// without the calls they make internally.
+//
+// If the environment variable OGLEMATCHERS_TRACE is set to a non-empty value,
+// a tracer writing to stderr is added when the package is initialized.
+func AddMatchObserver(o MatchObserver) (remove func()) {
+	observersMutex.Lock()
+	defer observersMutex.Unlock()
+
+	id := nextObserverID
+	nextObserverID++
+	observers = append(observers, registeredObserver{id, o})
+	atomic.AddInt32(&observerCount, 1)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			observersMutex.Lock()
+			defer observersMutex.Unlock()
+
+			for i, r := range observers {
+				if r.id == id {
+					observers = append(observers[:i:i], observers[i+1:]...)
+					break
+				}
+			}
+
+			atomic.AddInt32(&observerCount, -1)
+		})
+	}
+}
+
+// NewTracer returns an observer that writes an indented tree to w for each
+// top-level call to a matcher, showing each sub-matcher's description, the
+// candidate it was given, and the result. For example:
+//
+//     greater than 0, and less than 10 <- 17: failed (3µs)
+//       greater than 0 <- 17: matched (1µs)
+//       less than 10 <- 17: failed (1µs)
+//
+func NewTracer(w io.Writer) MatchObserver {
+	t := &tracer{w: w}
+	return t.observe
+}
+
+func init() {
+	if os.Getenv("OGLEMATCHERS_TRACE") != "" {
+		AddMatchObserver(NewTracer(os.Stderr))
+	}
+}
+
+////////////////////////////////////////////////////////////////////////
+// Observation
+////////////////////////////////////////////////////////////////////////
+
+// The number of registered observers, read without holding observersMutex so
+// that matchers stay cheap when nobody is observing.
+var observerCount int32
+
+var observersMutex sync.RWMutex
+var observers []registeredObserver
+var nextObserverID int
+
+type registeredObserver struct {
+	id int
+	o  MatchObserver
+}
+
+var nextChain uint64
+
+// The matchers in this package implement Matches by calling observeMatch,
+// which calls back to observedMatches to do the work.
+type observedMatcher interface {
+	Matcher
+
+	// Match c, calling any sub-matchers with o.matches so that their calls are
+	// observed as nested within this one. o is nil when there are no
+	// observers.
+	observedMatches(o *observation, c interface{}) error
+}
+
+// A call to a matcher in progress, while there are observers.
+type observation struct {
+	m     Matcher
+	c     interface{}
+	chain uint64
+	depth int
+	start time.Time
+}
+
+// Call m.observedMatches(c), telling the observers about it afterward. parent
+// is the observation of the call making this one, or nil for a top-level
+// call. Matchers use it like so:
+//
+//     func (m *fooMatcher) Matches(c interface{}) error {
+//       return observeMatch(nil, m, c)
+//     }
+//
+// When there are no observers this calls m.observedMatches(nil, c) directly,
+// and nothing is allocated.
+func observeMatch(
+	parent *observation,
+	m observedMatcher,
+	c interface{}) (err error) {
+	if atomic.LoadInt32(&observerCount) == 0 {
+		return m.observedMatches(nil, c)
+	}
+
+	o := &observation{m: m, c: c}
+	if parent == nil {
+		o.chain = atomic.AddUint64(&nextChain, 1)
+	} else {
+		o.chain = parent.chain
+		o.depth = parent.depth + 1
+	}
+
+	o.start = time.Now()
+	defer o.end(&err)
+
+	return m.observedMatches(o, c)
+}
+
+// Call m.Matches(c) on behalf of the observed call o. If m is one of the
+// matchers in this package its call is observed as nested within o, otherwise
+// any matchers it calls in turn are observed as top-level calls.
+func (o *observation) matches(m Matcher, c interface{}) error {
+	if om, ok := m.(observedMatcher); ok && o != nil {
+		return observeMatch(o, om, c)
+	}
+
+	return m.Matches(c)
+}
+
+func (o *observation) end(errp *error) {
+	e := MatchEvent{
+		Matcher:     o.m,
+		Candidate:   o.c,
+		Description: o.m.Description(),
+		Err:         *errp,
+		Duration:    time.Since(o.start),
+		Depth:       o.depth,
+		Chain:       o.chain,
+	}
+
+	observersMutex.RLock()
+	current := make([]MatchObserver, 0, len(observers))
+	for _, r := range observers {
+		current = append(current, r.o)
+	}
+	observersMutex.RUnlock()
+
+	for _, observer := range current {
+		observer(e)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tracer
+////////////////////////////////////////////////////////////////////////
+
+type tracer struct {
+	mu sync.Mutex
+	w  io.Writer
+
+	// Completed calls awaiting their parent, indexed by chain and then by
+	// depth.
+	pending map[uint64][][]*traceNode
+}
+
+type traceNode struct {
+	e        MatchEvent
+	children []*traceNode
+}
+
+func (t *tracer) observe(e MatchEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.pending == nil {
+		t.pending = make(map[uint64][][]*traceNode)
+	}
+
+	pending := t.pending[e.Chain]
+	for len(pending) <= e.Depth+1 {
+		pending = append(pending, nil)
+	}
+
+	n := &traceNode{e, pending[e.Depth+1]}
+	pending[e.Depth+1] = nil
+
+	if e.Depth > 0 {
+		pending[e.Depth] = append(pending[e.Depth], n)
+		t.pending[e.Chain] = pending
+		return
+	}
+
+	delete(t.pending, e.Chain)
+
+	var b strings.Builder
+	writeTraceNode(&b, n, 0)
+	io.WriteString(t.w, b.String())
+}
+
+func writeTraceNode(b *strings.Builder, n *traceNode, indent int) {
+	result := "matched"
+	if n.e.Err != nil {
+		result = "failed"
+		if _, isFatal := n.e.Err.(*FatalError); isFatal {
+			result = "failed fatally"
+		}
+
+		if s := n.e.Err.Error(); s != "" {
+			if i := strings.IndexByte(s, '\n'); i >= 0 {
+				s = s[:i] + " ..."
+			}
+
+			result += ", " + s
+		}
+	}
+
+	fmt.Fprintf(
+		b,
+		"%s%s <- %s: %s (%v)\n",
+		strings.Repeat("  ", indent),
+		n.e.Description,
+		FormatValue(n.e.Candidate),
+		result,
+		n.e.Duration)
+
+	for _, child := range n.children {
+		writeTraceNode(b, child, indent+1)
+	}
+}
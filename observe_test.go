@@ -0,0 +1,182 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"sync"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type ObserveTest struct {
+}
+
+func init() { RegisterTestSuite(&ObserveTest{}) }
+
+// Call m.Matches(c), returning the events observed during the call.
+func observeEvents(m Matcher, c interface{}) (events []MatchEvent) {
+	remove := AddMatchObserver(func(e MatchEvent) { events = append(events, e) })
+	defer remove()
+
+	m.Matches(c)
+	return
+}
+
+var durationRegexp = regexp.MustCompile(` \([^()]*s\)\n`)
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *ObserveTest) EventsArriveInPostOrder() {
+	m := AllOf(GreaterThan(0), Not(Equals(17)))
+	events := observeEvents(m, 17)
+
+	AssertEq(4, len(events))
+
+	ExpectEq("greater than 0", events[0].Description)
+	ExpectEq(1, events[0].Depth)
+	ExpectEq(17, events[0].Candidate)
+	ExpectEq(nil, events[0].Err)
+
+	ExpectEq("17", events[1].Description)
+	ExpectEq(2, events[1].Depth)
+	ExpectEq(nil, events[1].Err)
+
+	ExpectEq("is not equal to 17", events[2].Description)
+	ExpectEq(1, events[2].Depth)
+	ExpectNe(nil, events[2].Err)
+
+	ExpectEq(m, events[3].Matcher)
+	ExpectEq(0, events[3].Depth)
+	ExpectNe(nil, events[3].Err)
+	ExpectGe(events[3].Duration, events[0].Duration)
+}
+
+func (t *ObserveTest) SubMatchersGetTheirOwnCandidates() {
+	events := observeEvents(ElementsAre(1, LessThan(3)), []int{1, 2})
+
+	AssertEq(3, len(events))
+	ExpectEq(1, events[0].Candidate)
+	ExpectEq(2, events[1].Candidate)
+	ExpectThat(events[2].Candidate, ElementsAre(1, 2))
+}
+
+func (t *ObserveTest) RemovingObservers() {
+	var count int
+	remove := AddMatchObserver(func(e MatchEvent) { count++ })
+
+	Equals(17).Matches(17)
+	remove()
+	Equals(17).Matches(17)
+
+	// Removing again has no effect.
+	remove()
+
+	ExpectEq(1, count)
+}
+
+func (t *ObserveTest) Tracer() {
+	buf := new(bytes.Buffer)
+	remove := AddMatchObserver(NewTracer(buf))
+
+	AnyOf(LessThan(0), AllOf(GreaterThan(0), HasSubstr("taco"))).Matches(17)
+	Equals(17).Matches(17)
+	remove()
+
+	ExpectEq(
+		"or(less than 0, greater than 0, and has substring \"taco\") <- 17: "+
+			"failed fatally, which is not a string\n"+
+			"  less than 0 <- 17: failed\n"+
+			"  greater than 0, and has substring \"taco\" <- 17: "+
+			"failed fatally, which is not a string\n"+
+			"    greater than 0 <- 17: matched\n"+
+			"    has substring \"taco\" <- 17: failed fatally, which is not a string\n"+
+			"17 <- 17: matched\n",
+		durationRegexp.ReplaceAllString(buf.String(), "\n"))
+}
+
+func (t *ObserveTest) TracerTruncatesMultiLineErrors() {
+	buf := new(bytes.Buffer)
+	remove := AddMatchObserver(NewTracer(buf))
+
+	NewMatcher(
+		func(c interface{}) error { return NewFatalError("line 1\nline 2") },
+		"is weird").Matches(17)
+	remove()
+
+	ExpectEq(
+		"is weird <- 17: failed fatally, line 1 ...\n",
+		durationRegexp.ReplaceAllString(buf.String(), "\n"))
+}
+
+func (t *ObserveTest) ConcurrentCallsAreTrackedSeparately() {
+	var mu sync.Mutex
+	chains := make(map[uint64][]MatchEvent)
+	remove := AddMatchObserver(func(e MatchEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+		chains[e.Chain] = append(chains[e.Chain], e)
+	})
+
+	buf := new(bytes.Buffer)
+	removeTracer := AddMatchObserver(NewTracer(buf))
+
+	const n = 50
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			AllOf(GreaterThan(i-1), LessThan(i+1)).Matches(i)
+		}(i)
+	}
+
+	wg.Wait()
+	remove()
+	removeTracer()
+
+	// Each top-level call has its own chain, with consistent depths.
+	AssertEq(n, len(chains))
+	for _, events := range chains {
+		AssertEq(3, len(events))
+		ExpectEq(1, events[0].Depth)
+		ExpectEq(1, events[1].Depth)
+		ExpectEq(0, events[2].Depth)
+		ExpectEq(events[2].Candidate, events[0].Candidate)
+		ExpectEq(events[2].Candidate, events[1].Candidate)
+	}
+
+	// The tracer writes each tree intact.
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	AssertEq(3*n, len(lines))
+	for i := 0; i < len(lines); i += 3 {
+		AssertFalse(strings.HasPrefix(lines[i], " "), "%s", lines[i])
+		c := lines[i][strings.Index(lines[i], "<- ") : strings.Index(lines[i], ":")+1]
+		ExpectThat(lines[i+1], HasSubstr("  greater than"))
+		ExpectThat(lines[i+1], HasSubstr(c))
+		ExpectThat(lines[i+2], HasSubstr("  less than"))
+		ExpectThat(lines[i+2], HasSubstr(c))
+	}
+}
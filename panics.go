@@ -41,7 +41,17 @@ func (m *panicsMatcher) Description() string {
 	return "panics with: " + m.wrappedMatcher.Description()
 }
 
-func (m *panicsMatcher) Matches(c interface{}) (err error) {
+func (m *panicsMatcher) DescribeNegation() string {
+	return "doesn't panic with: " + m.wrappedMatcher.Description()
+}
+
+func (m *panicsMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *panicsMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
 	// Make sure c is a zero-arg function.
 	v := reflect.ValueOf(c)
 	if v.Kind() != reflect.Func || v.Type().NumIn() != 0 {
@@ -52,7 +62,7 @@ func (m *panicsMatcher) Matches(c interface{}) (err error) {
 	// Call the function and check its panic error.
 	defer func() {
 		if e := recover(); e != nil {
-			err = m.wrappedMatcher.Matches(e)
+			err = o.matches(m.wrappedMatcher, e)
 
 			// Set a clearer error message if the matcher said no.
 			if err != nil {
@@ -61,7 +71,7 @@ func (m *panicsMatcher) Matches(c interface{}) (err error) {
 					wrappedClause = ", " + err.Error()
 				}
 
-				err = errors.New(fmt.Sprintf("which panicked with: %v%s", e, wrappedClause))
+				err = errors.New(fmt.Sprintf("which panicked with: %s%s", FormatValue(e), wrappedClause))
 			}
 		}
 	}()
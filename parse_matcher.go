@@ -0,0 +1,521 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseMatcher builds a matcher from a textual expression, for use in
+// data-driven tests whose expectations live in fixture files. The grammar is
+// as follows, with the usual precedence (! binds tightest, then &&, then ||):
+//
+//     expr    := and { "||" and }             AnyOf
+//     and     := unary { "&&" unary }         AllOf
+//     unary   := "!" unary                    Not
+//              | primary
+//     primary := "(" expr ")"
+//              | literal                      Equals
+//              | "==" literal                 Equals
+//              | "!=" literal                 Not(Equals)
+//              | "<" literal                  LessThan
+//              | "<=" literal                 LessOrEqual
+//              | ">" literal                  GreaterThan
+//              | ">=" literal                 GreaterOrEqual
+//              | "~" "/" regexp "/"           MatchesRegexp
+//              | "contains" unary             Contains
+//              | "len" unary                  length of string, slice, map, ...
+//              | "[" [ elem { "," elem } ] "]"  ElementsAre
+//              | "*"                          Any
+//     elem    := expr
+//     literal := number | string | "true" | "false" | "nil"
+//
+// Numbers are Go integer or floating point literals, optionally negative, and
+// strings are Go double-quoted string literals. Within a regexp, "\/" stands
+// for a slash. When the operand of "contains" is a string literal, the matcher
+// also accepts strings containing it as a substring.
+//
+// For example:
+//
+//     >= 2 && < 10
+//     ~ /^ta.o$/ || "burrito"
+//     len == 3 && contains "taco"
+//     [1, *, !nil]
+//
+// Syntax errors are reported as a *ParseError.
+func ParseMatcher(expr string) (m Matcher, err error) {
+	p := &matcherParser{s: expr}
+
+	if m, err = p.parseExpr(); err != nil {
+		return
+	}
+
+	p.skipSpace()
+	if p.pos != len(p.s) {
+		m = nil
+		err = p.errorf("unexpected %s", p.describeNext())
+		return
+	}
+
+	return
+}
+
+// ParseError is the type of error returned by ParseMatcher for syntactically
+// invalid expressions.
+type ParseError struct {
+	// The byte offset within the expression at which the error was detected.
+	Offset int
+
+	// A description of the error.
+	Msg string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("at offset %d: %s", e.Offset, e.Msg)
+}
+
+type matcherParser struct {
+	s   string
+	pos int
+}
+
+func (p *matcherParser) errorf(format string, a ...interface{}) error {
+	return &ParseError{p.pos, fmt.Sprintf(format, a...)}
+}
+
+func (p *matcherParser) describeNext() string {
+	if p.pos >= len(p.s) {
+		return "end of expression"
+	}
+
+	r, _ := utf8.DecodeRuneInString(p.s[p.pos:])
+	return strconv.QuoteRune(r)
+}
+
+func (p *matcherParser) skipSpace() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// Consume the supplied token if it comes next, returning true if so. Keywords
+// must not be immediately followed by another identifier character.
+func (p *matcherParser) consume(tok string) bool {
+	p.skipSpace()
+	if !strings.HasPrefix(p.s[p.pos:], tok) {
+		return false
+	}
+
+	end := p.pos + len(tok)
+	if isIdentByte(tok[len(tok)-1]) && end < len(p.s) && isIdentByte(p.s[end]) {
+		return false
+	}
+
+	p.pos = end
+	return true
+}
+
+func (p *matcherParser) parseExpr() (Matcher, error) {
+	var alternatives []interface{}
+	for {
+		m, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		alternatives = append(alternatives, m)
+		if !p.consume("||") {
+			break
+		}
+	}
+
+	if len(alternatives) == 1 {
+		return alternatives[0].(Matcher), nil
+	}
+
+	return AnyOf(alternatives...), nil
+}
+
+func (p *matcherParser) parseAnd() (Matcher, error) {
+	var conjuncts []Matcher
+	for {
+		m, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		conjuncts = append(conjuncts, m)
+		if !p.consume("&&") {
+			break
+		}
+	}
+
+	if len(conjuncts) == 1 {
+		return conjuncts[0], nil
+	}
+
+	return AllOf(conjuncts...), nil
+}
+
+func (p *matcherParser) parseUnary() (Matcher, error) {
+	// Be careful not to mistake "!=" for negation.
+	p.skipSpace()
+	if !strings.HasPrefix(p.s[p.pos:], "!=") && p.consume("!") {
+		m, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return Not(m), nil
+	}
+
+	return p.parsePrimary()
+}
+
+// Comparison operators, longest first so that e.g. "<=" isn't read as "<".
+var comparisons = []struct {
+	op   string
+	ctor func(interface{}) Matcher
+}{
+	{"==", Equals},
+	{"!=", func(x interface{}) Matcher { return Not(Equals(x)) }},
+	{"<=", LessOrEqual},
+	{">=", GreaterOrEqual},
+	{"<", LessThan},
+	{">", GreaterThan},
+}
+
+func (p *matcherParser) parsePrimary() (m Matcher, err error) {
+	switch {
+	case p.consume("("):
+		if m, err = p.parseExpr(); err != nil {
+			return
+		}
+
+		if !p.consume(")") {
+			err = p.errorf("expected ')', found %s", p.describeNext())
+		}
+
+		return
+
+	case p.consume("["):
+		return p.parseList()
+
+	case p.consume("~"):
+		return p.parseRegexp()
+
+	case p.consume("*"):
+		return Any(), nil
+
+	case p.consume("contains"):
+		p.skipSpace()
+		if p.pos < len(p.s) && p.s[p.pos] == '"' {
+			var x interface{}
+			if x, err = p.parseLiteral(); err != nil {
+				return
+			}
+
+			return newContainsExprMatcher(x), nil
+		}
+
+		var operand Matcher
+		if operand, err = p.parseUnary(); err != nil {
+			return
+		}
+
+		return newContainsExprMatcher(operand), nil
+
+	case p.consume("len"):
+		var operand Matcher
+		if operand, err = p.parseUnary(); err != nil {
+			return
+		}
+
+		return &lengthMatcher{operand}, nil
+	}
+
+	for _, c := range comparisons {
+		if p.consume(c.op) {
+			return p.parseComparison(c.op, c.ctor)
+		}
+	}
+
+	// Otherwise this must be a literal.
+	if !p.atLiteral() {
+		err = p.errorf("expected matcher, found %s", p.describeNext())
+		return
+	}
+
+	var x interface{}
+	if x, err = p.parseLiteral(); err != nil {
+		return
+	}
+
+	return Equals(x), nil
+}
+
+func (p *matcherParser) parseComparison(
+	op string,
+	ctor func(interface{}) Matcher) (m Matcher, err error) {
+	var x interface{}
+	if x, err = p.parseLiteral(); err != nil {
+		return
+	}
+
+	// The ordering matchers panic on unsupported types; report a syntax error
+	// instead.
+	if op != "==" && op != "!=" {
+		v := reflect.ValueOf(x)
+		if !isInteger(v) && !isFloat(v) && v.Kind() != reflect.String {
+			err = p.errorf("%s requires a number or string", op)
+			return
+		}
+	}
+
+	return ctor(x), nil
+}
+
+func (p *matcherParser) parseList() (Matcher, error) {
+	var elems []interface{}
+
+	if p.consume("]") {
+		return ElementsAre(), nil
+	}
+
+	for {
+		m, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+
+		elems = append(elems, m)
+		if p.consume("]") {
+			break
+		}
+
+		if !p.consume(",") {
+			return nil, p.errorf("expected ',' or ']', found %s", p.describeNext())
+		}
+	}
+
+	return ElementsAre(elems...), nil
+}
+
+func (p *matcherParser) parseRegexp() (Matcher, error) {
+	p.skipSpace()
+	if p.pos >= len(p.s) || p.s[p.pos] != '/' {
+		return nil, p.errorf("expected '/', found %s", p.describeNext())
+	}
+
+	start := p.pos
+	var pattern []byte
+	for i := p.pos + 1; i < len(p.s); i++ {
+		switch {
+		case p.s[i] == '\\' && i+1 < len(p.s) && p.s[i+1] == '/':
+			pattern = append(pattern, '/')
+			i++
+
+		case p.s[i] == '/':
+			if _, err := regexp.Compile(string(pattern)); err != nil {
+				p.pos = start
+				return nil, p.errorf("invalid regexp: %v", err)
+			}
+
+			p.pos = i + 1
+			return MatchesRegexp(string(pattern)), nil
+
+		default:
+			pattern = append(pattern, p.s[i])
+		}
+	}
+
+	p.pos = start
+	return nil, p.errorf("unterminated regexp")
+}
+
+// Return true if the next token looks like the start of a literal.
+func (p *matcherParser) atLiteral() bool {
+	p.skipSpace()
+	if p.pos < len(p.s) && strings.IndexByte("\"-.0123456789", p.s[p.pos]) >= 0 {
+		return true
+	}
+
+	for _, kw := range []string{"true", "false", "nil"} {
+		if p.consume(kw) {
+			p.pos -= len(kw)
+			return true
+		}
+	}
+
+	return false
+}
+
+var numberRegexp = regexp.MustCompile(`^-?(0[xX][0-9a-fA-F_]+|0[bB][01_]+|0[oO]?[0-7_]*|[0-9][0-9_]*)?(\.[0-9_]*)?([eE][-+]?[0-9_]+)?`)
+
+func (p *matcherParser) parseLiteral() (x interface{}, err error) {
+	p.skipSpace()
+	rest := p.s[p.pos:]
+
+	switch {
+	case p.consume("true"):
+		return true, nil
+
+	case p.consume("false"):
+		return false, nil
+
+	case p.consume("nil"):
+		return nil, nil
+
+	case strings.HasPrefix(rest, `"`):
+		// Find the closing quote, skipping escapes.
+		for i := 1; i < len(rest); i++ {
+			switch rest[i] {
+			case '\\':
+				i++
+
+			case '"':
+				var s string
+				if s, err = strconv.Unquote(rest[:i+1]); err != nil {
+					err = p.errorf("invalid string literal")
+					return
+				}
+
+				p.pos += i + 1
+				return s, nil
+			}
+		}
+
+		err = p.errorf("unterminated string literal")
+		return
+	}
+
+	// Numbers.
+	lit := numberRegexp.FindString(rest)
+	if lit == "" || lit == "-" {
+		err = p.errorf("expected literal, found %s", p.describeNext())
+		return
+	}
+
+	if i, intErr := strconv.ParseInt(lit, 0, 64); intErr == nil {
+		x = i
+	} else if f, floatErr := strconv.ParseFloat(lit, 64); floatErr == nil {
+		x = f
+	} else {
+		err = p.errorf("invalid number %q", lit)
+		return
+	}
+
+	if end := p.pos + len(lit); end < len(p.s) && isIdentByte(p.s[end]) {
+		p.pos = end
+		err = p.errorf("unexpected %s after number", p.describeNext())
+		return
+	}
+
+	p.pos += len(lit)
+	return
+}
+
+// A matcher for "contains" expressions, which match slices and arrays with a
+// matching element, and if the operand was a string literal, strings with
+// that substring.
+type containsExprMatcher struct {
+	operand  interface{}
+	contains Matcher
+	substr   Matcher
+}
+
+// Return a matcher for a "contains" expression whose operand is either a
+// matcher or a string literal.
+func newContainsExprMatcher(operand interface{}) Matcher {
+	m := &containsExprMatcher{operand: operand, contains: Contains(operand)}
+	if s, ok := operand.(string); ok {
+		m.substr = HasSubstr(s)
+	}
+
+	return m
+}
+
+func (m *containsExprMatcher) Description() string {
+	return m.contains.Description()
+}
+
+func (m *containsExprMatcher) DescribeNegation() string {
+	return describeNegation(m.contains)
+}
+
+func (m *containsExprMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *containsExprMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	if m.substr != nil && reflect.ValueOf(c).Kind() == reflect.String {
+		return o.matches(m.substr, c)
+	}
+
+	return o.matches(m.contains, c)
+}
+
+// A matcher for "len" expressions, which match values whose length matches
+// the wrapped matcher.
+type lengthMatcher struct {
+	wrapped Matcher
+}
+
+func (m *lengthMatcher) Description() string {
+	return fmt.Sprintf("has length %s", m.wrapped.Description())
+}
+
+func (m *lengthMatcher) DescribeNegation() string {
+	return fmt.Sprintf("doesn't have length %s", m.wrapped.Description())
+}
+
+func (m *lengthMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *lengthMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	v := reflect.ValueOf(c)
+	switch v.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+	default:
+		return NewFatalError("which has no length")
+	}
+
+	if err := o.matches(m.wrapped, v.Len()); err != nil {
+		s := fmt.Sprintf("which has length %d", v.Len())
+		if _, isFatal := err.(*FatalError); isFatal {
+			return NewFatalError(s)
+		}
+
+		return errors.New(s)
+	}
+
+	return nil
+}
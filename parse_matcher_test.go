@@ -0,0 +1,268 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type ParseMatcherTest struct {
+}
+
+func init() { RegisterTestSuite(&ParseMatcherTest{}) }
+
+func parseOrDie(expr string) Matcher {
+	m, err := ParseMatcher(expr)
+	if err != nil {
+		panic("ParseMatcher(" + expr + "): " + err.Error())
+	}
+
+	return m
+}
+
+type parseMatcherCase struct {
+	candidate interface{}
+	matches   bool
+}
+
+func checkParsedMatcher(expr string, cases []parseMatcherCase) {
+	m := parseOrDie(expr)
+	for _, c := range cases {
+		err := m.Matches(c.candidate)
+		ExpectEq(c.matches, err == nil, "expr: %s, candidate: %v, err: %v", expr, c.candidate, err)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *ParseMatcherTest) Literals() {
+	checkParsedMatcher("17", []parseMatcherCase{
+		{17, true},
+		{17.0, true},
+		{uint8(17), true},
+		{19, false},
+	})
+
+	checkParsedMatcher("-2.5e1", []parseMatcherCase{
+		{-25, true},
+		{25, false},
+	})
+
+	checkParsedMatcher("0x11", []parseMatcherCase{
+		{17, true},
+	})
+
+	checkParsedMatcher(`"ta\"co"`, []parseMatcherCase{
+		{"ta\"co", true},
+		{"taco", false},
+	})
+
+	checkParsedMatcher("true", []parseMatcherCase{
+		{true, true},
+		{false, false},
+	})
+
+	checkParsedMatcher("nil", []parseMatcherCase{
+		{nil, true},
+		{(*int)(nil), true},
+		{17, false},
+	})
+}
+
+func (t *ParseMatcherTest) Comparisons() {
+	checkParsedMatcher("> 5", []parseMatcherCase{
+		{6, true},
+		{5, false},
+	})
+
+	checkParsedMatcher(">=5", []parseMatcherCase{
+		{5, true},
+		{4.5, false},
+	})
+
+	checkParsedMatcher("< \"m\"", []parseMatcherCase{
+		{"burrito", true},
+		{"taco", false},
+	})
+
+	checkParsedMatcher("<= -1", []parseMatcherCase{
+		{-1, true},
+		{0, false},
+	})
+
+	checkParsedMatcher("== 17", []parseMatcherCase{
+		{17, true},
+		{18, false},
+	})
+
+	checkParsedMatcher("!= 17", []parseMatcherCase{
+		{17, false},
+		{18, true},
+	})
+}
+
+func (t *ParseMatcherTest) BooleanOperators() {
+	checkParsedMatcher(">= 2 && < 10", []parseMatcherCase{
+		{1, false},
+		{2, true},
+		{9, true},
+		{10, false},
+	})
+
+	checkParsedMatcher("< 0 || > 10 && < 20", []parseMatcherCase{
+		{-1, true},
+		{5, false},
+		{15, true},
+		{25, false},
+	})
+
+	checkParsedMatcher("(< 0 || > 10) && < 20", []parseMatcherCase{
+		{-1, true},
+		{15, true},
+		{25, false},
+	})
+
+	checkParsedMatcher("!(> 5)", []parseMatcherCase{
+		{5, true},
+		{6, false},
+	})
+
+	checkParsedMatcher("!!nil", []parseMatcherCase{
+		{nil, true},
+		{17, false},
+	})
+}
+
+func (t *ParseMatcherTest) Regexps() {
+	checkParsedMatcher(`~ /^ta.o$/`, []parseMatcherCase{
+		{"taco", true},
+		{"tacos", false},
+	})
+
+	checkParsedMatcher(`~/a\/b/`, []parseMatcherCase{
+		{"a/b", true},
+		{"ab", false},
+	})
+}
+
+func (t *ParseMatcherTest) Contains() {
+	checkParsedMatcher(`contains "ac"`, []parseMatcherCase{
+		{"taco", true},
+		{"burrito", false},
+		{[]string{"ac", "b"}, true},
+		{[]string{"taco"}, false},
+	})
+
+	checkParsedMatcher(`contains > 5`, []parseMatcherCase{
+		{[]int{1, 7}, true},
+		{[]int{1, 2}, false},
+	})
+}
+
+func (t *ParseMatcherTest) Length() {
+	checkParsedMatcher("len == 3", []parseMatcherCase{
+		{"abc", true},
+		{[]int{1, 2, 3}, true},
+		{map[int]int{1: 1, 2: 2, 3: 3}, true},
+		{[2]int{}, false},
+		{17, false},
+	})
+
+	checkParsedMatcher("len (> 0 && < 3)", []parseMatcherCase{
+		{"", false},
+		{"a", true},
+		{"abc", false},
+	})
+
+	m := parseOrDie("len 3")
+	ExpectEq("has length 3", m.Description())
+	ExpectThat(m.Matches("ab"), Error(Equals("which has length 2")))
+	ExpectThat(m.Matches(17), Error(Equals("which has no length")))
+}
+
+func (t *ParseMatcherTest) Lists() {
+	checkParsedMatcher(`[1, "taco", *]`, []parseMatcherCase{
+		{[]interface{}{1, "taco", nil}, true},
+		{[]interface{}{1, "taco", 17}, true},
+		{[]interface{}{1, "burrito", 17}, false},
+		{[]interface{}{1, "taco"}, false},
+	})
+
+	checkParsedMatcher(`[]`, []parseMatcherCase{
+		{[]int{}, true},
+		{[]int{1}, false},
+	})
+
+	checkParsedMatcher(`[> 1 && < 3, [~/a/]]`, []parseMatcherCase{
+		{[]interface{}{2, []string{"taco"}}, true},
+		{[]interface{}{2, []string{"queso"}}, false},
+	})
+}
+
+func (t *ParseMatcherTest) Descriptions() {
+	ExpectEq("17", parseOrDie("17").Description())
+	ExpectEq("is not equal to 17", parseOrDie("!17").Description())
+	ExpectEq("doesn't have length 3", parseOrDie("!len 3").Description())
+	ExpectEq("is not equal to 17", parseOrDie("!= 17").Description())
+	ExpectEq(
+		"greater than or equal to 2, and less than 10",
+		parseOrDie(">= 2 && < 10").Description())
+	ExpectEq(`contains: "taco"`, parseOrDie(`contains "taco"`).Description())
+}
+
+func (t *ParseMatcherTest) SyntaxErrors() {
+	cases := []struct {
+		expr   string
+		offset int
+		msg    string
+	}{
+		{"", 0, "expected matcher, found end of expression"},
+		{"17 19", 3, "unexpected '1'"},
+		{">= 2 &&", 7, "expected matcher, found end of expression"},
+		{"(17", 3, "expected ')', found end of expression"},
+		{"[1, 2", 5, "expected ',' or ']', found end of expression"},
+		{"[1 2]", 3, "expected ',' or ']', found '2'"},
+		{"~ taco", 2, "expected '/', found 't'"},
+		{"~ /taco", 2, "unterminated regexp"},
+		{"~ /(/", 2, "invalid regexp: error parsing regexp: missing closing ): `(`"},
+		{`"taco`, 0, "unterminated string literal"},
+		{"> foo", 2, "expected literal, found 'f'"},
+		{"> nil", 5, "> requires a number or string"},
+		{"17abc", 2, "unexpected 'a' after number"},
+		{"containsx", 0, "expected matcher, found 'c'"},
+	}
+
+	for _, c := range cases {
+		m, err := ParseMatcher(c.expr)
+		ExpectEq(nil, m, "expr: %q", c.expr)
+		AssertNe(nil, err, "expr: %q", c.expr)
+
+		pe, ok := err.(*ParseError)
+		AssertTrue(ok, "expr: %q", c.expr)
+		ExpectEq(c.offset, pe.Offset, "expr: %q", c.expr)
+		ExpectEq(c.msg, pe.Msg, "expr: %q", c.expr)
+	}
+
+	_, err := ParseMatcher("17 19")
+	ExpectThat(err, Error(Equals("at offset 3: unexpected '1'")))
+}
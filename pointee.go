@@ -31,7 +31,13 @@ type pointeeMatcher struct {
 	wrapped Matcher
 }
 
-func (m *pointeeMatcher) Matches(c interface{}) (err error) {
+func (m *pointeeMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *pointeeMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
 	// Make sure the candidate is of the appropriate type.
 	cv := reflect.ValueOf(c)
 	if !cv.IsValid() || cv.Kind() != reflect.Ptr {
@@ -46,9 +52,9 @@ func (m *pointeeMatcher) Matches(c interface{}) (err error) {
 	// Defer to the wrapped matcher. Fix up empty errors so that failure messages
 	// are more helpful than just printing a pointer for "Actual".
 	pointee := cv.Elem().Interface()
-	err = m.wrapped.Matches(pointee)
+	err = o.matches(m.wrapped, pointee)
 	if err != nil && err.Error() == "" {
-		s := fmt.Sprintf("whose pointee is %v", pointee)
+		s := fmt.Sprintf("whose pointee is %s", FormatValue(pointee))
 
 		if _, ok := err.(*FatalError); ok {
 			err = NewFatalError(s)
@@ -63,3 +69,7 @@ func (m *pointeeMatcher) Matches(c interface{}) (err error) {
 func (m *pointeeMatcher) Description() string {
 	return fmt.Sprintf("pointee(%s)", m.wrapped.Description())
 }
+
+func (m *pointeeMatcher) DescribeNegation() string {
+	return fmt.Sprintf("pointee(%s)", describeNegation(m.wrapped))
+}
@@ -0,0 +1,453 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// FormatValue returns a string representation of x suitable for use in
+// matcher descriptions and failure text. The built-in matchers use it for all
+// values they print, and matchers defined elsewhere are encouraged to do the
+// same.
+//
+// The representation is much like that of fmt's %v verb, with the following
+// differences:
+//
+//  *  Strings are quoted using Go syntax, so that empty strings and special
+//     characters are visible.
+//
+//  *  Struct fields are shown with their names, as with %+v.
+//
+//  *  Non-nil pointers are shown along with their target, as in
+//     "0xc000012345 -> 17". Cycles are shown as "<cycle>".
+//
+//  *  Nil slices and maps are shown as "<nil slice>" and "<nil map>", since
+//     they are distinct from empty ones as far as DeepEquals is concerned.
+//
+//  *  Output longer than the limit set with SetMaxPrintedLength is truncated.
+//
+// Values of types with a printer registered using RegisterPrinter are shown
+// using that printer, at any level of nesting. Otherwise values implementing
+// error or fmt.Stringer are shown using the corresponding method.
+func FormatValue(x interface{}) string {
+	p := &valuePrinter{
+		limit:   int(atomic.LoadInt64(&maxPrintedLength)),
+		visited: make(map[visitedValue]bool),
+	}
+
+	p.print(reflect.ValueOf(x))
+	return p.String()
+}
+
+// RegisterPrinter arranges for FormatValue to use the supplied function to
+// print values whose dynamic type is exactly t. It is safe to call
+// concurrently with FormatValue, but it is typically called from an init
+// function. A later registration for the same type replaces an earlier one.
+func RegisterPrinter(t reflect.Type, f func(v interface{}) string) {
+	printersMutex.Lock()
+	defer printersMutex.Unlock()
+
+	printers[t] = f
+}
+
+// SetMaxPrintedLength sets the length in bytes beyond which the output of
+// FormatValue is truncated, returning the previous limit. A limit of zero or
+// less disables truncation. The default is 1024.
+func SetMaxPrintedLength(n int) (previous int) {
+	return int(atomic.SwapInt64(&maxPrintedLength, int64(n)))
+}
+
+var maxPrintedLength int64 = 1024
+
+var printersMutex sync.RWMutex
+var printers = make(map[reflect.Type]func(interface{}) string)
+
+func lookUpPrinter(t reflect.Type) func(interface{}) string {
+	printersMutex.RLock()
+	defer printersMutex.RUnlock()
+
+	return printers[t]
+}
+
+const truncationMarker = "..."
+
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+// Identifies a pointer, map, or slice that is currently being printed, so
+// that cycles can be detected.
+type visitedValue struct {
+	ptr uintptr
+	t   reflect.Type
+	len int
+}
+
+type valuePrinter struct {
+	strings.Builder
+
+	// The maximum length of the output, or zero or less for no limit.
+	limit     int
+	truncated bool
+
+	visited map[visitedValue]bool
+}
+
+func (p *valuePrinter) write(s string) {
+	if p.truncated {
+		return
+	}
+
+	if p.limit > 0 && p.Len()+len(s) > p.limit {
+		// Avoid splitting a UTF-8 sequence.
+		n := p.limit - p.Len()
+		for n > 0 && !utf8.RuneStart(s[n]) {
+			n--
+		}
+
+		p.WriteString(s[:n])
+		p.WriteString(truncationMarker)
+		p.truncated = true
+		return
+	}
+
+	p.WriteString(s)
+}
+
+// Mark the supplied value as being printed, returning false if it already is.
+// The caller must call leave if enter returns true.
+func (p *valuePrinter) enter(k visitedValue) bool {
+	if p.visited[k] {
+		return false
+	}
+
+	p.visited[k] = true
+	return true
+}
+
+func (p *valuePrinter) leave(k visitedValue) {
+	delete(p.visited, k)
+}
+
+func (p *valuePrinter) print(v reflect.Value) {
+	if p.truncated {
+		return
+	}
+
+	if !v.IsValid() {
+		p.write("<nil>")
+		return
+	}
+
+	// Prefer a registered printer, then the value's own methods.
+	if v.CanInterface() {
+		if f := lookUpPrinter(v.Type()); f != nil {
+			p.write(f(v.Interface()))
+			return
+		}
+
+		if p.printUsingMethods(v) {
+			return
+		}
+	}
+
+	if s, ok := formatScalar(v); ok {
+		p.write(s)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		if v.Pointer() == 0 {
+			p.write("<nil>")
+			return
+		}
+
+		p.write(fmt.Sprintf("%#x", v.Pointer()))
+
+	case reflect.Interface:
+		p.print(v.Elem())
+
+	case reflect.Ptr:
+		p.printPtr(v)
+
+	case reflect.Array:
+		p.printElems(v)
+
+	case reflect.Slice:
+		p.printSlice(v)
+
+	case reflect.Map:
+		p.printMap(v)
+
+	case reflect.Struct:
+		p.printStruct(v)
+
+	default:
+		p.write(fmt.Sprintf("%v", v))
+	}
+}
+
+// Format a boolean, numeric, or string value, returning false for values of
+// other kinds.
+func formatScalar(v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), true
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), true
+
+	case reflect.Complex64, reflect.Complex128:
+		return strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits()), true
+
+	case reflect.String:
+		return strconv.Quote(v.String()), true
+	}
+
+	return "", false
+}
+
+// Print the value using its Error or String method, if it has one. Return
+// false if it doesn't.
+func (p *valuePrinter) printUsingMethods(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		if v.IsNil() {
+			return false
+		}
+	}
+
+	if v.Type().Implements(errorType) {
+		p.write(v.Interface().(error).Error())
+		return true
+	}
+
+	if v.Type().Implements(stringerType) {
+		p.write(v.Interface().(fmt.Stringer).String())
+		return true
+	}
+
+	return false
+}
+
+func (p *valuePrinter) printPtr(v reflect.Value) {
+	if v.IsNil() {
+		p.write("<nil>")
+		return
+	}
+
+	p.write(fmt.Sprintf("%#x -> ", v.Pointer()))
+
+	k := visitedValue{ptr: v.Pointer(), t: v.Type()}
+	if !p.enter(k) {
+		p.write("<cycle>")
+		return
+	}
+
+	defer p.leave(k)
+	p.print(v.Elem())
+}
+
+func (p *valuePrinter) printSlice(v reflect.Value) {
+	if v.IsNil() {
+		p.write("<nil slice>")
+		return
+	}
+
+	k := visitedValue{ptr: v.Pointer(), t: v.Type(), len: v.Len()}
+	if !p.enter(k) {
+		p.write("<cycle>")
+		return
+	}
+
+	defer p.leave(k)
+	p.printElems(v)
+}
+
+func (p *valuePrinter) printElems(v reflect.Value) {
+	p.write("[")
+	for i := 0; i < v.Len() && !p.truncated; i++ {
+		if i != 0 {
+			p.write(" ")
+		}
+
+		p.print(v.Index(i))
+	}
+
+	p.write("]")
+}
+
+func (p *valuePrinter) printMap(v reflect.Value) {
+	if v.IsNil() {
+		p.write("<nil map>")
+		return
+	}
+
+	k := visitedValue{ptr: v.Pointer(), t: v.Type()}
+	if !p.enter(k) {
+		p.write("<cycle>")
+		return
+	}
+
+	defer p.leave(k)
+
+	// Print each key once, then order the keys so that the output doesn't
+	// depend on map iteration order.
+	type entry struct {
+		key     reflect.Value
+		printed string
+	}
+
+	entries := make([]entry, 0, v.Len())
+	for _, key := range v.MapKeys() {
+		kp := &valuePrinter{visited: p.visited}
+		kp.print(key)
+		entries = append(entries, entry{key, kp.String()})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if c := compareForPrinting(a.key, b.key); c != 0 {
+			return c < 0
+		}
+
+		return a.printed < b.printed
+	})
+
+	p.write("map[")
+	for i, e := range entries {
+		if i != 0 {
+			p.write(" ")
+		}
+
+		p.write(e.printed)
+		p.write(":")
+		p.print(v.MapIndex(e.key))
+	}
+
+	p.write("]")
+}
+
+func (p *valuePrinter) printStruct(v reflect.Value) {
+	t := v.Type()
+
+	p.write("{")
+	for i := 0; i < v.NumField(); i++ {
+		if i != 0 {
+			p.write(" ")
+		}
+
+		p.write(t.Field(i).Name)
+		p.write(":")
+		p.print(v.Field(i))
+	}
+
+	p.write("}")
+}
+
+// Define a total order on map keys for printing purposes: keys are ordered by
+// kind, then by type name, then naturally if they are numbers or strings.
+// Return zero if that doesn't decide, leaving the caller to break the tie by
+// printed form. Interfaces are looked through.
+func compareForPrinting(a, b reflect.Value) int {
+	for a.Kind() == reflect.Interface && !a.IsNil() {
+		a = a.Elem()
+	}
+
+	for b.Kind() == reflect.Interface && !b.IsNil() {
+		b = b.Elem()
+	}
+
+	if a.Kind() != b.Kind() {
+		return compareInts(int64(a.Kind()), int64(b.Kind()))
+	}
+
+	if !a.IsValid() {
+		return 0
+	}
+
+	// Distinct types may have the same name, so compare by name and then by
+	// value regardless, keeping the order total.
+	if c := strings.Compare(a.Type().String(), b.Type().String()); c != 0 {
+		return c
+	}
+
+	switch {
+	case isSignedInteger(a):
+		return compareInts(a.Int(), b.Int())
+
+	case isUnsignedInteger(a):
+		switch {
+		case a.Uint() < b.Uint():
+			return -1
+
+		case a.Uint() > b.Uint():
+			return 1
+		}
+
+	case isFloat(a):
+		// Order NaNs before all other numbers, so that the order is total.
+		af, bf := a.Float(), b.Float()
+		switch {
+		case af != af || bf != bf:
+			return compareInts(boolToInt(af == af), boolToInt(bf == bf))
+
+		case af < bf:
+			return -1
+
+		case af > bf:
+			return 1
+		}
+
+	case a.Kind() == reflect.String:
+		return strings.Compare(a.String(), b.String())
+	}
+
+	return 0
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type PrinterTest struct {
+}
+
+func init() { RegisterTestSuite(&PrinterTest{}) }
+
+type printerNode struct {
+	Name string
+	Next *printerNode
+}
+
+type printerMoney struct {
+	cents int64
+}
+
+func init() {
+	RegisterPrinter(
+		reflect.TypeOf(printerMoney{}),
+		func(v interface{}) string {
+			m := v.(printerMoney)
+			return fmt.Sprintf("$%d.%02d", m.cents/100, m.cents%100)
+		})
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *PrinterTest) Nil() {
+	ExpectEq("<nil>", FormatValue(nil))
+	ExpectEq("<nil>", FormatValue((*int)(nil)))
+	ExpectEq("<nil>", FormatValue((func())(nil)))
+	ExpectEq("<nil slice>", FormatValue(([]int)(nil)))
+	ExpectEq("<nil map>", FormatValue((map[int]int)(nil)))
+}
+
+func (t *PrinterTest) Scalars() {
+	ExpectEq("true", FormatValue(true))
+	ExpectEq("-17", FormatValue(int8(-17)))
+	ExpectEq("17", FormatValue(uint64(17)))
+	ExpectEq("17.5", FormatValue(17.5))
+	ExpectEq("3.3554432e+07", FormatValue(float32(1<<25)))
+	ExpectEq("(17+0.25i)", FormatValue(complex64(17+0.25i)))
+}
+
+func (t *PrinterTest) Strings() {
+	type stringAlias string
+
+	ExpectEq(`""`, FormatValue(""))
+	ExpectEq(`"taco"`, FormatValue("taco"))
+	ExpectEq(`"taco"`, FormatValue(stringAlias("taco")))
+	ExpectEq(`"say \"hi\"\n\x00"`, FormatValue("say \"hi\"\n\x00"))
+}
+
+func (t *PrinterTest) SlicesAndArrays() {
+	ExpectEq("[]", FormatValue([]int{}))
+	ExpectEq("[17 19]", FormatValue([]int{17, 19}))
+	ExpectEq(`["taco" "burrito"]`, FormatValue([...]string{"taco", "burrito"}))
+	ExpectEq(`[17 "taco" <nil>]`, FormatValue([]interface{}{17, "taco", nil}))
+}
+
+func (t *PrinterTest) MapsHaveSortedKeys() {
+	ExpectEq("map[]", FormatValue(map[int]int{}))
+	ExpectEq(
+		"map[2:\"b\" 10:\"c\" 17:\"a\"]",
+		FormatValue(map[int]string{17: "a", 2: "b", 10: "c"}))
+
+	ExpectEq(
+		"map[\"burrito\":2 \"enchilada\":3 \"taco\":1]",
+		FormatValue(map[string]int{"taco": 1, "burrito": 2, "enchilada": 3}))
+}
+
+func (t *PrinterTest) MapsWithMixedKeysHaveSortedKeys() {
+	m := map[interface{}]int{100: 1, 20: 2, 15.0: 3, 2.5: 4, "taco": 5}
+
+	s := FormatValue(m)
+	ExpectEq("map[20:2 100:1 2.5:4 15:3 \"taco\":5]", s)
+
+	for i := 0; i < 100; i++ {
+		ExpectEq(s, FormatValue(m))
+	}
+}
+
+func (t *PrinterTest) Structs() {
+	type inner struct {
+		i int
+	}
+
+	type outer struct {
+		Name  string
+		Inner inner
+	}
+
+	ExpectEq(`{Name:"taco" Inner:{i:17}}`, FormatValue(outer{"taco", inner{17}}))
+}
+
+func (t *PrinterTest) Pointers() {
+	i := 17
+	ExpectEq(fmt.Sprintf("%p -> 17", &i), FormatValue(&i))
+
+	n := &printerNode{Name: "taco"}
+	ExpectEq(fmt.Sprintf(`%p -> {Name:"taco" Next:<nil>}`, n), FormatValue(n))
+}
+
+func (t *PrinterTest) Cycles() {
+	var s string
+
+	// Pointer cycle
+	n := &printerNode{Name: "taco"}
+	n.Next = n
+
+	s = FormatValue(n)
+	ExpectEq(fmt.Sprintf(`%p -> {Name:"taco" Next:%p -> <cycle>}`, n, n), s)
+
+	// Slice cycle
+	sl := make([]interface{}, 1)
+	sl[0] = sl
+	ExpectEq("[<cycle>]", FormatValue(sl))
+
+	// Map cycle
+	m := make(map[string]interface{})
+	m["self"] = m
+	ExpectEq(`map["self":<cycle>]`, FormatValue(m))
+}
+
+func (t *PrinterTest) SharedValuesAreNotCycles() {
+	shared := &printerNode{Name: "taco"}
+	s := FormatValue([]*printerNode{shared, shared})
+
+	ExpectThat(s, Not(HasSubstr("cycle")))
+	ExpectEq(2, strings.Count(s, "taco"))
+}
+
+func (t *PrinterTest) ErrorsAndStringers() {
+	ExpectEq("taco", FormatValue(errors.New("taco")))
+	ExpectEq("1.5s", FormatValue(1500*time.Millisecond))
+	ExpectEq("[1s 2s]", FormatValue([]time.Duration{time.Second, 2 * time.Second}))
+}
+
+func (t *PrinterTest) RegisteredPrinter() {
+	ExpectEq("$17.05", FormatValue(printerMoney{1705}))
+	ExpectEq("[$0.01 $1.00]", FormatValue([]printerMoney{{1}, {100}}))
+	ExpectEq("deep equals: $17.05", DeepEquals(printerMoney{1705}).Description())
+}
+
+func (t *PrinterTest) Truncation() {
+	defer SetMaxPrintedLength(SetMaxPrintedLength(10))
+
+	ExpectEq(`"taco"`, FormatValue("taco"))
+	ExpectEq(`"tacoburri...`, FormatValue("tacoburrito"))
+	ExpectEq("[0 0 0 0 0...", FormatValue(make([]int, 1000000)))
+
+	// Multi-byte characters are not split.
+	ExpectEq(`"tacoburr...`, FormatValue("tacoburré"))
+}
+
+func (t *PrinterTest) NoTruncation() {
+	defer SetMaxPrintedLength(SetMaxPrintedLength(0))
+
+	s := FormatValue(strings.Repeat("a", 10000))
+	ExpectEq(10002, len(s))
+}
+
+func (t *PrinterTest) MatchersUseThePrinter() {
+	ExpectEq(`"taco"`, Equals("taco").Description())
+	ExpectEq(`has substring "\n"`, HasSubstr("\n").Description())
+	ExpectEq(`less than "\x00"`, LessThan("\x00").Description())
+	ExpectEq(`matches regexp "\\d"`, MatchesRegexp(`\d`).Description())
+	ExpectEq(`deep equals: ["taco"]`, DeepEquals([]string{"taco"}).Description())
+}
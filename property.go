@@ -0,0 +1,140 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// Property returns a matcher that calls the zero-arg method with the supplied
+// name on the candidate and matches its result against m. If m is not a
+// Matcher, it is treated as Equals(m).
+//
+// The method is looked up on the candidate itself, and if it is not a pointer,
+// on a pointer to a copy of it. The method must return either a single value,
+// or a value and an error; in the latter case a non-nil error is treated as a
+// fatal error. Nil pointers are also rejected with a fatal error, whatever
+// the method's receiver.
+//
+// For example:
+//
+//     Property("Len", 4)                        // bytes.NewBufferString("taco")
+//     Property("Name", MatchesRegexp(`\.go$`))  // the *os.File for "main.go"
+//
+func Property(name string, m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &propertyMatcher{name, wrapped}
+}
+
+type propertyMatcher struct {
+	name    string
+	wrapped Matcher
+}
+
+func (m *propertyMatcher) Description() string {
+	return fmt.Sprintf("whose %s() matches: %s", m.name, m.wrapped.Description())
+}
+
+func (m *propertyMatcher) DescribeNegation() string {
+	return fmt.Sprintf("whose %s() doesn't match: %s", m.name, m.wrapped.Description())
+}
+
+// Find the named method for the supplied candidate, or return a fatal error.
+func (m *propertyMatcher) findMethod(c interface{}) (reflect.Value, error) {
+	cv := reflect.ValueOf(c)
+	if !cv.IsValid() {
+		return reflect.Value{}, NewFatalError("which is nil")
+	}
+
+	// Calling a method through a nil pointer would most likely panic.
+	if cv.Kind() == reflect.Ptr && cv.IsNil() {
+		return reflect.Value{}, NewFatalError("which is a nil pointer")
+	}
+
+	meth := cv.MethodByName(m.name)
+
+	// Methods with pointer receivers aren't in the method set of the value
+	// itself, so try a pointer to a copy.
+	if !meth.IsValid() && cv.Kind() != reflect.Ptr {
+		p := reflect.New(cv.Type())
+		p.Elem().Set(cv)
+		meth = p.MethodByName(m.name)
+	}
+
+	if !meth.IsValid() {
+		return reflect.Value{}, NewFatalError(fmt.Sprintf("which has no method %s", m.name))
+	}
+
+	// Check the method's signature.
+	t := meth.Type()
+	if t.NumIn() != 0 {
+		return reflect.Value{}, NewFatalError(
+			fmt.Sprintf("whose method %s takes arguments", m.name))
+	}
+
+	switch {
+	case t.NumOut() == 1:
+	case t.NumOut() == 2 && t.Out(1) == errorType:
+	default:
+		return reflect.Value{}, NewFatalError(
+			fmt.Sprintf("whose method %s doesn't return a value and optional error", m.name))
+	}
+
+	return meth, nil
+}
+
+func (m *propertyMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *propertyMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	meth, err := m.findMethod(c)
+	if err != nil {
+		return err
+	}
+
+	// Call the method, treating a returned error as fatal.
+	out := meth.Call(nil)
+	if len(out) == 2 && !out[1].IsNil() {
+		return NewFatalError(fmt.Sprintf("whose %s() returned error: %s", m.name, FormatValue(out[1].Interface())))
+	}
+
+	// Defer to the wrapped matcher. Fix up empty errors so that failure messages
+	// are more helpful than just printing the candidate for "Actual".
+	result := out[0].Interface()
+	err = o.matches(m.wrapped, result)
+	if err != nil && err.Error() == "" {
+		s := fmt.Sprintf("whose %s() is %s", m.name, FormatValue(result))
+
+		if _, ok := err.(*FatalError); ok {
+			err = NewFatalError(s)
+		} else {
+			err = errors.New(s)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"bytes"
+	"errors"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type PropertyTest struct {
+}
+
+func init() { RegisterTestSuite(&PropertyTest{}) }
+
+type propertyHolder struct {
+	name string
+	err  error
+}
+
+func (h propertyHolder) Name() string            { return h.name }
+func (h *propertyHolder) PtrName() string        { return h.name }
+func (h propertyHolder) Status() (int, error)    { return 17, h.err }
+func (h propertyHolder) Pair() (int, int)        { return 17, 19 }
+func (h propertyHolder) Nothing()                {}
+func (h propertyHolder) WithArg(s string) string { return s }
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *PropertyTest) Description() {
+	m := Property("Name", &fakeMatcher{nil, "taco"})
+	ExpectEq("whose Name() matches: taco", m.Description())
+
+	m = Property("Len", 4)
+	ExpectEq("whose Len() matches: 4", m.Description())
+}
+
+func (t *PropertyTest) NilCandidate() {
+	err := Property("Name", "taco").Matches(nil)
+
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is nil")))
+}
+
+func (t *PropertyTest) MissingMethod() {
+	err := Property("Taco", "").Matches(propertyHolder{})
+
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which has no method Taco")))
+}
+
+func (t *PropertyTest) UnexportedMethod() {
+	err := Property("name", "").Matches(propertyHolder{})
+
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which has no method name")))
+}
+
+func (t *PropertyTest) MethodTakesArguments() {
+	err := Property("WithArg", "").Matches(propertyHolder{})
+
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("whose method WithArg takes arguments")))
+}
+
+func (t *PropertyTest) MethodHasWrongResults() {
+	var err error
+
+	err = Property("Nothing", "").Matches(propertyHolder{})
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("method Nothing doesn't return")))
+
+	err = Property("Pair", "").Matches(propertyHolder{})
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("method Pair doesn't return")))
+}
+
+func (t *PropertyTest) ValueReceiver() {
+	m := Property("Name", "taco")
+
+	ExpectEq(nil, m.Matches(propertyHolder{name: "taco"}))
+	ExpectEq(nil, m.Matches(&propertyHolder{name: "taco"}))
+}
+
+func (t *PropertyTest) PointerReceiver() {
+	m := Property("PtrName", "taco")
+
+	ExpectEq(nil, m.Matches(propertyHolder{name: "taco"}))
+	ExpectEq(nil, m.Matches(&propertyHolder{name: "taco"}))
+}
+
+func (t *PropertyTest) NilPointerCandidate() {
+	var err error
+
+	// Value receiver
+	err = Property("Name", "").Matches((*propertyHolder)(nil))
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is a nil pointer")))
+
+	// Pointer receiver
+	err = Property("PtrName", "").Matches((*propertyHolder)(nil))
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is a nil pointer")))
+
+	err = Property("Len", 0).Matches((*bytes.Buffer)(nil))
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is a nil pointer")))
+}
+
+func (t *PropertyTest) InterfaceCandidate() {
+	var err error
+
+	err = Property("Len", 4).Matches(bytes.NewBufferString("taco"))
+	ExpectEq(nil, err)
+
+	err = Property("Error", HasSubstr("taco")).Matches(errors.New("taco"))
+	ExpectEq(nil, err)
+}
+
+func (t *PropertyTest) ValueAndError() {
+	m := Property("Status", 17)
+	var err error
+
+	err = m.Matches(propertyHolder{})
+	ExpectEq(nil, err)
+
+	err = m.Matches(propertyHolder{err: errors.New("taco")})
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("whose Status() returned error: taco")))
+}
+
+func (t *PropertyTest) WrappedReturnsNonFatalEmptyError() {
+	err := Property("Name", "taco").Matches(propertyHolder{name: "burrito"})
+
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose Name() is \"burrito\"")))
+}
+
+func (t *PropertyTest) WrappedReturnsNonFatalNonEmptyError() {
+	wrapped := &fakeMatcher{
+		func(c interface{}) error { return errors.New("taco") },
+		"",
+	}
+
+	err := Property("Name", wrapped).Matches(propertyHolder{})
+
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("taco")))
+}
+
+func (t *PropertyTest) WrappedReturnsFatalEmptyError() {
+	wrapped := &fakeMatcher{
+		func(c interface{}) error { return NewFatalError("") },
+		"",
+	}
+
+	err := Property("Name", wrapped).Matches(propertyHolder{name: "burrito"})
+
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("whose Name() is \"burrito\"")))
+}
+
+func (t *PropertyTest) WrappedReturnsFatalNonEmptyError() {
+	err := Property("Name", LessThan(17)).Matches(propertyHolder{})
+
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which is not comparable")))
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ReaderContents returns a matcher that matches values implementing io.Reader
+// whose contents, read until EOF, match m. If m is not a Matcher, it is
+// treated as Equals(m).
+//
+// The contents are given to m as a string, so that matchers such as
+// HasSubstr and MatchesRegexp can be used directly. If m returns a fatal error
+// for the string, it is given the contents as a []byte instead.
+//
+// The reader is consumed in the process. Errors other than io.EOF returned by
+// the reader are reported as fatal errors.
+func ReaderContents(m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &readerContentsMatcher{wrapped}
+}
+
+type readerContentsMatcher struct {
+	wrapped Matcher
+}
+
+func (m *readerContentsMatcher) Description() string {
+	return fmt.Sprintf("whose contents match: %s", m.wrapped.Description())
+}
+
+func (m *readerContentsMatcher) DescribeNegation() string {
+	return fmt.Sprintf("whose contents don't match: %s", m.wrapped.Description())
+}
+
+func (m *readerContentsMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *readerContentsMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	r, ok := c.(io.Reader)
+	if !ok {
+		return NewFatalError("which is not an io.Reader")
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return NewFatalError(fmt.Sprintf("which couldn't be read: %v", err))
+	}
+
+	return matchContents(o, m.wrapped, data)
+}
+
+// Match the supplied data against m on behalf of the observed call o, first as
+// a string and then, if that yields a fatal error, as a []byte.
+func matchStringOrBytes(o *observation, m Matcher, data []byte) error {
+	err := o.matches(m, string(data))
+	if _, isFatal := err.(*FatalError); isFatal {
+		bytesErr := o.matches(m, data)
+		if _, isFatal := bytesErr.(*FatalError); !isFatal {
+			err = bytesErr
+		}
+	}
+
+	return err
+}
+
+// Like matchStringOrBytes, but describe the contents in any error.
+func matchContents(o *observation, m Matcher, data []byte) error {
+	err := matchStringOrBytes(o, m, data)
+	if err == nil {
+		return nil
+	}
+
+	wrappedClause := ""
+	if err.Error() != "" {
+		wrappedClause = ", " + err.Error()
+	}
+
+	s := fmt.Sprintf("whose contents are %s%s", FormatValue(string(data)), wrappedClause)
+	if _, isFatal := err.(*FatalError); isFatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type ReaderContentsTest struct {
+}
+
+func init() { RegisterTestSuite(&ReaderContentsTest{}) }
+
+// A reader that returns an error after some data.
+type failingReader struct {
+	data string
+	err  error
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.data == "" {
+		return 0, r.err
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *ReaderContentsTest) Description() {
+	m := ReaderContents(HasSubstr("taco"))
+	ExpectEq("whose contents match: has substring \"taco\"", m.Description())
+	ExpectEq("whose contents don't match: has substring \"taco\"", Not(m).Description())
+
+	ExpectEq("whose contents match: \"taco\"", ReaderContents("taco").Description())
+}
+
+func (t *ReaderContentsTest) WrongTypeCandidates() {
+	m := ReaderContents("")
+
+	var err error
+
+	err = m.Matches(nil)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is not an io.Reader")))
+
+	err = m.Matches("taco")
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is not an io.Reader")))
+}
+
+func (t *ReaderContentsTest) ReadErrors() {
+	r := &failingReader{"taco", errors.New("connection reset")}
+	err := ReaderContents(Any()).Matches(r)
+
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which couldn't be read: connection reset")))
+}
+
+func (t *ReaderContentsTest) StringMatchers() {
+	ExpectEq(nil, ReaderContents("taco").Matches(strings.NewReader("taco")))
+	ExpectEq(nil, ReaderContents(HasSubstr("ac")).Matches(strings.NewReader("taco")))
+	ExpectEq(nil, ReaderContents(MatchesRegexp("^t.c")).Matches(bytes.NewBufferString("taco")))
+
+	err := ReaderContents(HasSubstr("burrito")).Matches(strings.NewReader("taco"))
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose contents are \"taco\"")))
+
+	err = ReaderContents(Not(HasSubstr("a"))).Matches(strings.NewReader("taco"))
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose contents are \"taco\"")))
+}
+
+func (t *ReaderContentsTest) ByteSliceMatchers() {
+	m := ReaderContents(DeepEquals([]byte("taco")))
+
+	ExpectEq(nil, m.Matches(strings.NewReader("taco")))
+
+	err := m.Matches(strings.NewReader("burrito"))
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("whose contents are \"burrito\"")))
+}
+
+func (t *ReaderContentsTest) WrappedFatalErrors() {
+	err := ReaderContents(LessThan(17)).Matches(strings.NewReader("taco"))
+
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("whose contents are \"taco\"")))
+}
+
+func (t *ReaderContentsTest) ReaderIsConsumed() {
+	r := io.MultiReader(strings.NewReader("ta"), strings.NewReader("co"))
+
+	ExpectEq(nil, ReaderContents("taco").Matches(r))
+	ExpectEq(nil, ReaderContents("").Matches(r))
+}
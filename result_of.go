@@ -0,0 +1,111 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ResultOf returns a matcher that applies the function fn to the candidate
+// and matches the result against m. fn must be a function of one argument
+// with a single return value; the candidate must be assignable to the type of
+// the argument. desc is a noun phrase describing the result, used in
+// descriptions and failure text. If m is not a Matcher, it is treated as
+// Equals(m).
+//
+// For example:
+//
+//     strlen := func(s string) int { return len(s) }
+//     ResultOf("length", strlen, GreaterThan(3))  // matches "taco", not "sub"
+//
+// ResultOf will panic if fn is not a function of the expected shape.
+func ResultOf(desc string, fn interface{}, m interface{}) Matcher {
+	fv := reflect.ValueOf(fn)
+	if fv.Kind() != reflect.Func ||
+		fv.Type().NumIn() != 1 ||
+		fv.Type().NumOut() != 1 ||
+		fv.Type().IsVariadic() {
+		panic(fmt.Sprintf("ResultOf: %v is not a function of one argument with one result", fv.Type()))
+	}
+
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &resultOfMatcher{desc, fv, wrapped}
+}
+
+type resultOfMatcher struct {
+	desc    string
+	fn      reflect.Value
+	wrapped Matcher
+}
+
+func (m *resultOfMatcher) Description() string {
+	return fmt.Sprintf("whose %s matches: %s", m.desc, m.wrapped.Description())
+}
+
+func (m *resultOfMatcher) DescribeNegation() string {
+	return fmt.Sprintf("whose %s doesn't match: %s", m.desc, m.wrapped.Description())
+}
+
+func (m *resultOfMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *resultOfMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	// Make sure the candidate can be passed to the function.
+	in := m.fn.Type().In(0)
+	arg := reflect.ValueOf(c)
+
+	switch {
+	case !arg.IsValid():
+		switch in.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+			arg = reflect.Zero(in)
+
+		default:
+			return NewFatalError(fmt.Sprintf("which is nil, not assignable to %v", in))
+		}
+
+	case !arg.Type().AssignableTo(in):
+		return NewFatalError(fmt.Sprintf("which is of type %v, not assignable to %v", arg.Type(), in))
+	}
+
+	// Call the function and defer to the wrapped matcher.
+	result := m.fn.Call([]reflect.Value{arg})[0].Interface()
+	err = o.matches(m.wrapped, result)
+	if err == nil {
+		return nil
+	}
+
+	wrappedClause := ""
+	if err.Error() != "" {
+		wrappedClause = ", " + err.Error()
+	}
+
+	s := fmt.Sprintf("whose %s is %s%s", m.desc, FormatValue(result), wrappedClause)
+	if _, isFatal := err.(*FatalError); isFatal {
+		return NewFatalError(s)
+	}
+
+	return errors.New(s)
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type ResultOfTest struct {
+}
+
+func init() { RegisterTestSuite(&ResultOfTest{}) }
+
+func strlen(s string) int { return len(s) }
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *ResultOfTest) Description() {
+	m := ResultOf("length", strlen, &fakeMatcher{nil, "taco"})
+	ExpectEq("whose length matches: taco", m.Description())
+
+	m = ResultOf("length", strlen, 4)
+	ExpectEq("whose length matches: 4", m.Description())
+}
+
+func (t *ResultOfTest) InvalidFunctions() {
+	ExpectThat(
+		func() { ResultOf("", 17, 0) },
+		Panics(HasSubstr("not a function")))
+
+	ExpectThat(
+		func() { ResultOf("", func() int { return 0 }, 0) },
+		Panics(HasSubstr("not a function of one argument")))
+
+	ExpectThat(
+		func() { ResultOf("", func(int) {}, 0) },
+		Panics(HasSubstr("with one result")))
+
+	ExpectThat(
+		func() { ResultOf("", func(...int) int { return 0 }, 0) },
+		Panics(HasSubstr("not a function of one argument")))
+}
+
+func (t *ResultOfTest) CandidateOfWrongType() {
+	m := ResultOf("length", strlen, 4)
+	err := m.Matches(17)
+
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is of type int, not assignable to string")))
+}
+
+func (t *ResultOfTest) NilCandidate() {
+	var err error
+
+	// Non-nillable argument
+	err = ResultOf("length", strlen, 4).Matches(nil)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("which is nil, not assignable to string")))
+
+	// Nillable argument
+	isNil := func(r io.Reader) bool { return r == nil }
+	err = ResultOf("nilness", isNil, true).Matches(nil)
+	ExpectEq(nil, err)
+}
+
+func (t *ResultOfTest) AssignableCandidate() {
+	read := func(r io.Reader) string {
+		b, _ := io.ReadAll(r)
+		return string(b)
+	}
+
+	m := ResultOf("contents", read, HasSubstr("taco"))
+	ExpectEq(nil, m.Matches(strings.NewReader("burrito taco")))
+}
+
+func (t *ResultOfTest) WrappedReturnsOkay() {
+	m := ResultOf("length", strlen, LessThan(5))
+	ExpectEq(nil, m.Matches("taco"))
+}
+
+func (t *ResultOfTest) WrappedReturnsNonFatalEmptyError() {
+	m := ResultOf("length", strlen, 4)
+	err := m.Matches("burrito")
+
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose length is 7")))
+}
+
+func (t *ResultOfTest) WrappedReturnsNonFatalNonEmptyError() {
+	wrapped := &fakeMatcher{
+		func(c interface{}) error { return errors.New("which is odd") },
+		"",
+	}
+
+	m := ResultOf("length", strlen, wrapped)
+	err := m.Matches("burrito")
+
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose length is 7, which is odd")))
+}
+
+func (t *ResultOfTest) WrappedReturnsFatalError() {
+	m := ResultOf("length", strlen, HasSubstr("7"))
+	err := m.Matches("burrito")
+
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(Equals("whose length is 7, which is not a string")))
+}
@@ -0,0 +1,401 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// SnapshotDir is the directory in which MatchesSnapshot keeps snapshots,
+// relative to the working directory of the test (normally the package
+// directory).
+var SnapshotDir = filepath.Join("testdata", "snapshots")
+
+// The file extension used for snapshots.
+const snapshotExt = ".snap"
+
+// MatchesSnapshot returns a matcher that renders candidates with
+// FormatSnapshot and compares the result against the snapshot with the
+// supplied name, which is typically the name of the test. Snapshots are kept
+// in files within SnapshotDir; characters in the name other than letters,
+// digits, '.', '-', and '_' are replaced with '_' to form the file name.
+//
+// If the snapshot doesn't yet exist, it is created from the candidate and the
+// matcher matches. Otherwise a mismatch results in an error showing a unified
+// diff from the snapshot to the candidate. If UpdateGoldenFiles is true,
+// existing snapshots are overwritten rather than compared.
+//
+// See UnreferencedSnapshots for finding snapshots that are no longer used.
+func MatchesSnapshot(name string) Matcher {
+	return &snapshotMatcher{name}
+}
+
+// UnreferencedSnapshots returns the names of the snapshot files in
+// SnapshotDir that no matcher returned by MatchesSnapshot has compared against
+// in this process, sorted. It is intended to be called after all tests have
+// run, for example from TestMain, so that stale snapshots can be reported or
+// deleted. It returns an empty list if SnapshotDir doesn't exist.
+func UnreferencedSnapshots() ([]string, error) {
+	entries, err := os.ReadDir(SnapshotDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	referencedSnapshotsMutex.Lock()
+	defer referencedSnapshotsMutex.Unlock()
+
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), snapshotExt) {
+			continue
+		}
+
+		path := filepath.Join(SnapshotDir, e.Name())
+		if !referencedSnapshots[path] {
+			names = append(names, e.Name())
+		}
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
+
+// The paths of the snapshot files that have been compared against.
+var referencedSnapshotsMutex sync.Mutex
+var referencedSnapshots = make(map[string]bool)
+
+func snapshotPath(name string) string {
+	sanitized := strings.Map(
+		func(r rune) rune {
+			switch {
+			case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+				return r
+
+			case r == '.' || r == '-' || r == '_':
+				return r
+			}
+
+			return '_'
+		},
+		name)
+
+	return filepath.Join(SnapshotDir, sanitized+snapshotExt)
+}
+
+type snapshotMatcher struct {
+	name string
+}
+
+func (m *snapshotMatcher) Description() string {
+	return fmt.Sprintf("matches snapshot %s", FormatValue(m.name))
+}
+
+func (m *snapshotMatcher) DescribeNegation() string {
+	return fmt.Sprintf("doesn't match snapshot %s", FormatValue(m.name))
+}
+
+func (m *snapshotMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *snapshotMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	path := snapshotPath(m.name)
+
+	referencedSnapshotsMutex.Lock()
+	referencedSnapshots[path] = true
+	referencedSnapshotsMutex.Unlock()
+
+	actual := FormatSnapshot(c) + "\n"
+
+	data, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err) || (err == nil && UpdateGoldenFiles):
+		return m.write(path, actual)
+
+	case err != nil:
+		return NewFatalError(fmt.Sprintf("which can't be compared: %v", err))
+	}
+
+	if string(data) == actual {
+		return nil
+	}
+
+	return errors.New(
+		"which differs from the snapshot:\n" +
+			unifiedDiff(path, "candidate", string(data), actual))
+}
+
+func (m *snapshotMatcher) write(path string, contents string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return NewFatalError(fmt.Sprintf("which couldn't be written: %v", err))
+	}
+
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		return NewFatalError(fmt.Sprintf("which couldn't be written: %v", err))
+	}
+
+	return nil
+}
+
+// FormatSnapshot returns the rendering of x used by MatchesSnapshot. Unlike
+// FormatValue it spreads composite values over multiple lines, with a syntax
+// much like that of Go composite literals, and its output is deterministic:
+//
+//  *  Map entries are sorted by key.
+//
+//  *  Pointers are shown as "&" followed by their target, never as addresses.
+//     Cycles are shown as "<cycle>".
+//
+//  *  Channels and functions are shown only by type.
+//
+//  *  Output is never truncated.
+//
+// As with FormatValue, values of types with a printer registered with
+// RegisterPrinter, and values implementing error or fmt.Stringer, are shown
+// using the corresponding function or method.
+func FormatSnapshot(x interface{}) string {
+	p := &snapshotPrinter{visited: make(map[visitedValue]bool)}
+	p.print(reflect.ValueOf(x), 0)
+
+	return p.String()
+}
+
+type snapshotPrinter struct {
+	strings.Builder
+	visited map[visitedValue]bool
+}
+
+func (p *snapshotPrinter) newline(depth int) {
+	p.WriteString("\n")
+	p.WriteString(strings.Repeat("  ", depth))
+}
+
+func (p *snapshotPrinter) print(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		p.WriteString("nil")
+		return
+	}
+
+	if v.CanInterface() {
+		if f := lookUpPrinter(v.Type()); f != nil {
+			p.WriteString(f(v.Interface()))
+			return
+		}
+
+		// Reuse FormatValue's handling of errors and Stringers.
+		vp := &valuePrinter{visited: make(map[visitedValue]bool)}
+		if vp.printUsingMethods(v) {
+			p.WriteString(vp.String())
+			return
+		}
+	}
+
+	if s, ok := formatScalar(v); ok {
+		p.WriteString(s)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		if v.IsNil() {
+			fmt.Fprintf(p, "%v(nil)", v.Type())
+			return
+		}
+
+		fmt.Fprintf(p, "<%v>", v.Type())
+
+	case reflect.Interface:
+		p.print(v.Elem(), depth)
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			fmt.Fprintf(p, "(%v)(nil)", v.Type())
+			return
+		}
+
+		k := visitedValue{ptr: v.Pointer(), t: v.Type()}
+		if !p.enter(k) {
+			p.WriteString("<cycle>")
+			return
+		}
+
+		defer p.leave(k)
+		p.WriteString("&")
+		p.print(v.Elem(), depth)
+
+	case reflect.Array:
+		p.printElems(v, depth)
+
+	case reflect.Slice:
+		if v.IsNil() {
+			fmt.Fprintf(p, "%v(nil)", v.Type())
+			return
+		}
+
+		k := visitedValue{ptr: v.Pointer(), t: v.Type(), len: v.Len()}
+		if !p.enter(k) {
+			p.WriteString("<cycle>")
+			return
+		}
+
+		defer p.leave(k)
+		p.printElems(v, depth)
+
+	case reflect.Map:
+		p.printMap(v, depth)
+
+	case reflect.Struct:
+		p.printStruct(v, depth)
+
+	default:
+		fmt.Fprintf(p, "%v", v)
+	}
+}
+
+// Return the rendering of v at the supplied depth, sharing p's record of the
+// values being printed so that cycles are still detected.
+func (p *snapshotPrinter) render(v reflect.Value, depth int) string {
+	sub := &snapshotPrinter{visited: p.visited}
+	sub.print(v, depth)
+	return sub.String()
+}
+
+func (p *snapshotPrinter) enter(k visitedValue) bool {
+	if p.visited[k] {
+		return false
+	}
+
+	p.visited[k] = true
+	return true
+}
+
+func (p *snapshotPrinter) leave(k visitedValue) {
+	delete(p.visited, k)
+}
+
+func (p *snapshotPrinter) printElems(v reflect.Value, depth int) {
+	fmt.Fprintf(p, "%v{", v.Type())
+	if v.Len() == 0 {
+		p.WriteString("}")
+		return
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		p.newline(depth + 1)
+		p.print(v.Index(i), depth+1)
+		p.WriteString(",")
+	}
+
+	p.newline(depth)
+	p.WriteString("}")
+}
+
+func (p *snapshotPrinter) printMap(v reflect.Value, depth int) {
+	if v.IsNil() {
+		fmt.Fprintf(p, "%v(nil)", v.Type())
+		return
+	}
+
+	k := visitedValue{ptr: v.Pointer(), t: v.Type()}
+	if !p.enter(k) {
+		p.WriteString("<cycle>")
+		return
+	}
+
+	defer p.leave(k)
+
+	// Render each entry, then order them by their renderings so that the
+	// output doesn't depend on pointer addresses or map iteration order.
+	// Keys are first ordered as with FormatValue.
+	type entry struct {
+		key          reflect.Value
+		printedKey   string
+		printedValue string
+	}
+
+	entries := make([]entry, 0, v.Len())
+	for _, key := range v.MapKeys() {
+		entries = append(entries, entry{
+			key,
+			p.render(key, depth+1),
+			p.render(v.MapIndex(key), depth+1),
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if c := compareForPrinting(a.key, b.key); c != 0 {
+			return c < 0
+		}
+
+		if a.printedKey != b.printedKey {
+			return a.printedKey < b.printedKey
+		}
+
+		return a.printedValue < b.printedValue
+	})
+
+	fmt.Fprintf(p, "%v{", v.Type())
+	if len(entries) == 0 {
+		p.WriteString("}")
+		return
+	}
+
+	for _, e := range entries {
+		p.newline(depth + 1)
+		p.WriteString(e.printedKey)
+		p.WriteString(": ")
+		p.WriteString(e.printedValue)
+		p.WriteString(",")
+	}
+
+	p.newline(depth)
+	p.WriteString("}")
+}
+
+func (p *snapshotPrinter) printStruct(v reflect.Value, depth int) {
+	t := v.Type()
+
+	fmt.Fprintf(p, "%v{", t)
+	if v.NumField() == 0 {
+		p.WriteString("}")
+		return
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		p.newline(depth + 1)
+		p.WriteString(t.Field(i).Name)
+		p.WriteString(": ")
+		p.print(v.Field(i), depth+1)
+		p.WriteString(",")
+	}
+
+	p.newline(depth)
+	p.WriteString("}")
+}
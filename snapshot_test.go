@@ -0,0 +1,267 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type SnapshotTest struct {
+	dir         string
+	originalDir string
+}
+
+func init() { RegisterTestSuite(&SnapshotTest{}) }
+
+func (t *SnapshotTest) SetUp(i *TestInfo) {
+	var err error
+	t.dir, err = os.MkdirTemp("", "snapshot_test")
+	AssertEq(nil, err)
+
+	t.originalDir = SnapshotDir
+	SnapshotDir = filepath.Join(t.dir, "snapshots")
+}
+
+func (t *SnapshotTest) TearDown() {
+	SnapshotDir = t.originalDir
+	UpdateGoldenFiles = false
+	os.RemoveAll(t.dir)
+}
+
+type snapshotOrder struct {
+	ID       int
+	Items    []string
+	Prices   map[string]float64
+	Customer *snapshotCustomer
+	note     string
+}
+
+type snapshotCustomer struct {
+	Name     string
+	Referrer *snapshotCustomer
+}
+
+func (t *SnapshotTest) readSnapshot(name string) string {
+	data, err := os.ReadFile(filepath.Join(SnapshotDir, name))
+	AssertEq(nil, err)
+
+	return string(data)
+}
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *SnapshotTest) Description() {
+	m := MatchesSnapshot("FooTest.Bar")
+	ExpectEq("matches snapshot \"FooTest.Bar\"", m.Description())
+	ExpectEq("doesn't match snapshot \"FooTest.Bar\"", Not(m).Description())
+}
+
+func (t *SnapshotTest) FormatScalars() {
+	ExpectEq("nil", FormatSnapshot(nil))
+	ExpectEq("17", FormatSnapshot(17))
+	ExpectEq("17.5", FormatSnapshot(17.5))
+	ExpectEq("\"taco\\n\"", FormatSnapshot("taco\n"))
+	ExpectEq("(*int)(nil)", FormatSnapshot((*int)(nil)))
+	ExpectEq("[]int(nil)", FormatSnapshot(([]int)(nil)))
+	ExpectEq("[]int{}", FormatSnapshot([]int{}))
+	ExpectEq("map[string]int(nil)", FormatSnapshot((map[string]int)(nil)))
+	ExpectEq("<func()>", FormatSnapshot(func() {}))
+	ExpectEq("<chan int>", FormatSnapshot(make(chan int)))
+	ExpectEq("taco", FormatSnapshot(errors.New("taco")))
+}
+
+func (t *SnapshotTest) FormatComposites() {
+	o := &snapshotOrder{
+		ID:       17,
+		Items:    []string{"taco", "burrito"},
+		Prices:   map[string]float64{"taco": 1.5, "burrito": 2, "enchilada": 3.25},
+		Customer: &snapshotCustomer{Name: "Alice"},
+		note:     "extra salsa",
+	}
+
+	o.Customer.Referrer = o.Customer
+
+	ExpectEq(
+		`&oglematchers_test.snapshotOrder{
+  ID: 17,
+  Items: []string{
+    "taco",
+    "burrito",
+  },
+  Prices: map[string]float64{
+    "burrito": 2,
+    "enchilada": 3.25,
+    "taco": 1.5,
+  },
+  Customer: &oglematchers_test.snapshotCustomer{
+    Name: "Alice",
+    Referrer: <cycle>,
+  },
+  note: "extra salsa",
+}`,
+		FormatSnapshot(o))
+}
+
+func (t *SnapshotTest) FormatIsDeterministic() {
+	m := make(map[int]*snapshotCustomer)
+	for i := 0; i < 100; i++ {
+		m[i] = &snapshotCustomer{Name: "taco"}
+	}
+
+	s := FormatSnapshot(m)
+	for i := 0; i < 10; i++ {
+		ExpectEq(s, FormatSnapshot(m))
+	}
+}
+
+func (t *SnapshotTest) PointerKeysAreOrderedByContents() {
+	// Allocate the keys in an order that differs from that of their contents,
+	// and render equivalent maps built from separate allocations.
+	build := func() map[*snapshotCustomer]int {
+		m := make(map[*snapshotCustomer]int)
+		for i, name := range []string{"taco", "burrito", "enchilada", "taco"} {
+			m[&snapshotCustomer{Name: name}] = i
+		}
+
+		return m
+	}
+
+	s := FormatSnapshot(build())
+	ExpectEq(
+		`map[*oglematchers_test.snapshotCustomer]int{
+  &oglematchers_test.snapshotCustomer{
+    Name: "burrito",
+    Referrer: (*oglematchers_test.snapshotCustomer)(nil),
+  }: 1,
+  &oglematchers_test.snapshotCustomer{
+    Name: "enchilada",
+    Referrer: (*oglematchers_test.snapshotCustomer)(nil),
+  }: 2,
+  &oglematchers_test.snapshotCustomer{
+    Name: "taco",
+    Referrer: (*oglematchers_test.snapshotCustomer)(nil),
+  }: 0,
+  &oglematchers_test.snapshotCustomer{
+    Name: "taco",
+    Referrer: (*oglematchers_test.snapshotCustomer)(nil),
+  }: 3,
+}`,
+		s)
+
+	for i := 0; i < 10; i++ {
+		ExpectEq(s, FormatSnapshot(build()))
+	}
+}
+
+func (t *SnapshotTest) MixedKeysAreOrderedByKindThenValue() {
+	m := map[interface{}]int{100: 1, 20: 2, 15.0: 3, "taco": 4, uint(7): 5}
+
+	s := FormatSnapshot(m)
+	ExpectEq(
+		`map[interface {}]int{
+  20: 2,
+  100: 1,
+  7: 5,
+  15: 3,
+  "taco": 4,
+}`,
+		s)
+
+	for i := 0; i < 100; i++ {
+		ExpectEq(s, FormatSnapshot(m))
+	}
+}
+
+func (t *SnapshotTest) CreatedOnFirstRun() {
+	m := MatchesSnapshot("SnapshotTest.CreatedOnFirstRun")
+
+	err := m.Matches([]int{17, 19})
+	ExpectEq(nil, err)
+	ExpectEq("[]int{\n  17,\n  19,\n}\n", t.readSnapshot("SnapshotTest.CreatedOnFirstRun.snap"))
+
+	// Now it is compared against.
+	ExpectEq(nil, m.Matches([]int{17, 19}))
+	ExpectNe(nil, m.Matches([]int{17}))
+}
+
+func (t *SnapshotTest) NamesAreSanitized() {
+	err := MatchesSnapshot("Foo/bar baz").Matches(17)
+	ExpectEq(nil, err)
+	ExpectEq("17\n", t.readSnapshot("Foo_bar_baz.snap"))
+}
+
+func (t *SnapshotTest) MismatchShowsDiff() {
+	m := MatchesSnapshot("taco")
+	AssertEq(nil, m.Matches([]string{"taco", "burrito", "queso"}))
+
+	err := m.Matches([]string{"taco", "enchilada", "queso"})
+	ExpectFalse(isFatal(err))
+	ExpectThat(
+		err,
+		Error(Equals(
+			"which differs from the snapshot:\n"+
+				"--- "+filepath.Join(SnapshotDir, "taco.snap")+"\n"+
+				"+++ candidate\n"+
+				"@@ -1,5 +1,5 @@\n"+
+				" []string{\n"+
+				"   \"taco\",\n"+
+				"-  \"burrito\",\n"+
+				"+  \"enchilada\",\n"+
+				"   \"queso\",\n"+
+				" }\n")))
+}
+
+func (t *SnapshotTest) UpdateMode() {
+	m := MatchesSnapshot("taco")
+	AssertEq(nil, m.Matches(17))
+
+	UpdateGoldenFiles = true
+	ExpectEq(nil, m.Matches(19))
+	ExpectEq("19\n", t.readSnapshot("taco.snap"))
+}
+
+func (t *SnapshotTest) Unreferenced() {
+	var names []string
+	var err error
+
+	// No directory
+	names, err = UnreferencedSnapshots()
+	AssertEq(nil, err)
+	ExpectThat(names, ElementsAre())
+
+	// Some stale snapshots, plus a referenced one and an unrelated file.
+	AssertEq(nil, os.MkdirAll(SnapshotDir, 0755))
+	for _, name := range []string{"b.snap", "a.snap", "c.snap", "README"} {
+		AssertEq(nil, os.WriteFile(filepath.Join(SnapshotDir, name), []byte("17\n"), 0644))
+	}
+
+	AssertEq(nil, MatchesSnapshot("b").Matches(17))
+
+	names, err = UnreferencedSnapshots()
+	AssertEq(nil, err)
+	ExpectThat(names, ElementsAre("a.snap", "c.snap"))
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// IsSupersetOf returns a matcher that matches arrays, slices, and other
+// collections A for which each element of M can be paired with a distinct
+// element of A matching it, in any order. As with ElementsAre, elements of M
+// that are not matchers are treated as Equals(M[i]).
+//
+// Each element of A is used at most once, so for example
+// IsSupersetOf("taco", "taco") matches []string{"taco", "burrito", "taco"} but
+// not []string{"taco", "burrito"}.
+//
+// Fatal errors returned by the element matchers are treated as non-matches,
+// so that collections of mixed type may be matched.
+func IsSupersetOf(M ...interface{}) Matcher {
+	return &isSupersetOfMatcher{toMatchers(M)}
+}
+
+// IsSubsetOf returns a matcher that matches arrays, slices, and other
+// collections A for which each element of A can be paired with a distinct
+// element of M that it matches, in any order. As with ElementsAre, elements
+// of M that are not matchers are treated as Equals(M[i]).
+//
+// Each element of M is used at most once, so for example
+// IsSubsetOf("taco", "taco", "burrito") matches []string{"taco", "taco"} but
+// not []string{"taco", "taco", "taco"}.
+//
+// Fatal errors returned by the element matchers are treated as non-matches,
+// so that collections of mixed type may be matched.
+func IsSubsetOf(M ...interface{}) Matcher {
+	return &isSubsetOfMatcher{toMatchers(M)}
+}
+
+// Copy over matchers, or convert to Equals(x) for non-matcher x.
+func toMatchers(M []interface{}) []Matcher {
+	subMatchers := make([]Matcher, len(M))
+	for i, x := range M {
+		if matcher, ok := x.(Matcher); ok {
+			subMatchers[i] = matcher
+			continue
+		}
+
+		subMatchers[i] = Equals(x)
+	}
+
+	return subMatchers
+}
+
+func describeMatchers(matchers []Matcher) string {
+	descs := make([]string, len(matchers))
+	for i, m := range matchers {
+		descs[i] = m.Description()
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(descs, ", "))
+}
+
+type isSupersetOfMatcher struct {
+	subMatchers []Matcher
+}
+
+func (m *isSupersetOfMatcher) Description() string {
+	return fmt.Sprintf("is superset of: %s", describeMatchers(m.subMatchers))
+}
+
+func (m *isSupersetOfMatcher) DescribeNegation() string {
+	return fmt.Sprintf("is not superset of: %s", describeMatchers(m.subMatchers))
+}
+
+func (m *isSupersetOfMatcher) Matches(candidates interface{}) error {
+	return observeMatch(nil, m, candidates)
+}
+
+func (m *isSupersetOfMatcher) observedMatches(
+	o *observation,
+	candidates interface{}) (err error) {
+	src, elems, err := collectionElements(candidates)
+	if err != nil {
+		return err
+	}
+
+	// Find the matchers left without a partner.
+	_, matcherToElem := maxBipartiteMatching(o, elems, m.subMatchers)
+
+	var unsatisfied []Matcher
+	for j, i := range matcherToElem {
+		if i == -1 {
+			unsatisfied = append(unsatisfied, m.subMatchers[j])
+		}
+	}
+
+	if len(unsatisfied) != 0 {
+		err = errors.New(fmt.Sprintf(
+			"which has no distinct elements matching: %s",
+			describeMatchers(unsatisfied)))
+
+		return src.noteConsumed(err, len(elems))
+	}
+
+	return nil
+}
+
+type isSubsetOfMatcher struct {
+	subMatchers []Matcher
+}
+
+func (m *isSubsetOfMatcher) Description() string {
+	return fmt.Sprintf("is subset of: %s", describeMatchers(m.subMatchers))
+}
+
+func (m *isSubsetOfMatcher) DescribeNegation() string {
+	return fmt.Sprintf("is not subset of: %s", describeMatchers(m.subMatchers))
+}
+
+func (m *isSubsetOfMatcher) Matches(candidates interface{}) error {
+	return observeMatch(nil, m, candidates)
+}
+
+func (m *isSubsetOfMatcher) observedMatches(
+	o *observation,
+	candidates interface{}) (err error) {
+	src, elems, err := collectionElements(candidates)
+	if err != nil {
+		return err
+	}
+
+	// Find the elements left without a partner.
+	elemToMatcher, _ := maxBipartiteMatching(o, elems, m.subMatchers)
+
+	var unexpected []string
+	for i, j := range elemToMatcher {
+		if j == -1 {
+			unexpected = append(unexpected, FormatValue(elems[i]))
+		}
+	}
+
+	if len(unexpected) != 0 {
+		err = errors.New(fmt.Sprintf(
+			"which has unexpected elements: [%s]",
+			strings.Join(unexpected, ", ")))
+
+		return src.noteConsumed(err, len(elems))
+	}
+
+	return nil
+}
+
+// Return the elements of the supplied collection, or a fatal error if it
+// isn't one.
+func collectionElements(candidates interface{}) (*elementSource, []interface{}, error) {
+	src, ok := newElementSource(candidates, false)
+	if !ok {
+		return nil, nil, notACollection(false)
+	}
+
+	return src, src.all(), nil
+}
+
+// Compute a maximum matching in the bipartite graph with an edge between
+// element i and matcher j whenever matchers[j] matches elems[i]. The results
+// give the partner of each element and of each matcher, or -1 for those left
+// unpaired. The matchers are called on behalf of the observed call o.
+func maxBipartiteMatching(
+	o *observation,
+	elems []interface{},
+	matchers []Matcher) (elemToMatcher []int, matcherToElem []int) {
+	// Evaluate each matcher against each element exactly once.
+	edges := make([][]bool, len(elems))
+	for i, e := range elems {
+		edges[i] = make([]bool, len(matchers))
+		for j, m := range matchers {
+			edges[i][j] = o.matches(m, e) == nil
+		}
+	}
+
+	elemToMatcher = make([]int, len(elems))
+	for i := range elemToMatcher {
+		elemToMatcher[i] = -1
+	}
+
+	matcherToElem = make([]int, len(matchers))
+	for j := range matcherToElem {
+		matcherToElem[j] = -1
+	}
+
+	// Look for an augmenting path starting at element i, using Kuhn's
+	// algorithm.
+	var visited []bool
+	var augment func(i int) bool
+	augment = func(i int) bool {
+		for j := range matchers {
+			if !edges[i][j] || visited[j] {
+				continue
+			}
+
+			visited[j] = true
+			if matcherToElem[j] == -1 || augment(matcherToElem[j]) {
+				elemToMatcher[i] = j
+				matcherToElem[j] = i
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i := range elems {
+		visited = make([]bool, len(matchers))
+		augment(i)
+	}
+
+	return
+}
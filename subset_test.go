@@ -0,0 +1,188 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type SubsetTest struct {
+}
+
+func init() { RegisterTestSuite(&SubsetTest{}) }
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *SubsetTest) Descriptions() {
+	ExpectEq("is superset of: []", IsSupersetOf().Description())
+	ExpectEq(
+		"is superset of: [\"taco\", less than 17]",
+		IsSupersetOf("taco", LessThan(17)).Description())
+
+	ExpectEq("is subset of: []", IsSubsetOf().Description())
+	ExpectEq(
+		"is subset of: [\"taco\", less than 17]",
+		IsSubsetOf("taco", LessThan(17)).Description())
+}
+
+func (t *SubsetTest) WrongTypeCandidates() {
+	matchers := []Matcher{IsSupersetOf(17), IsSubsetOf(17)}
+
+	for _, m := range matchers {
+		var err error
+
+		// Nil candidate
+		err = m.Matches(nil)
+		ExpectTrue(isFatal(err))
+		ExpectThat(err, Error(Equals("which is not a slice, array, channel, iterator, or list")))
+
+		// Map candidate
+		err = m.Matches(map[int]int{0: 17})
+		ExpectTrue(isFatal(err))
+		ExpectThat(err, Error(Equals("which is not a slice, array, channel, iterator, or list")))
+	}
+}
+
+func (t *SubsetTest) SupersetMatches() {
+	m := IsSupersetOf("taco", HasSubstr("rit"))
+
+	var c interface{}
+	var err error
+
+	// Exact set, in order
+	c = []string{"taco", "burrito"}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+
+	// Out of order, with extras
+	c = [...]string{"enchilada", "burrito", "queso", "taco"}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+
+	// Mixed types; fatal errors are non-matches.
+	c = []interface{}{17, "burrito", "taco"}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+}
+
+func (t *SubsetTest) SupersetDoesntMatch() {
+	m := IsSupersetOf("taco", HasSubstr("rit"), LessThan("z"))
+
+	var c interface{}
+	var err error
+
+	// Empty
+	c = []string{}
+	err = m.Matches(c)
+	ExpectFalse(isFatal(err))
+	ExpectThat(
+		err,
+		Error(Equals(
+			"which has no distinct elements matching: "+
+				"[\"taco\", has substring \"rit\", less than \"z\"]")))
+
+	// Only a single element for two matchers.
+	c = []string{"taco", "burrito"}
+	err = m.Matches(c)
+	ExpectFalse(isFatal(err))
+	ExpectThat(
+		err,
+		Error(Equals("which has no distinct elements matching: [less than \"z\"]")))
+}
+
+func (t *SubsetTest) SupersetMultiplicity() {
+	m := IsSupersetOf("taco", "taco")
+
+	ExpectEq(nil, m.Matches([]string{"taco", "burrito", "taco"}))
+	ExpectThat(
+		m.Matches([]string{"taco", "burrito"}),
+		Error(Equals("which has no distinct elements matching: [\"taco\"]")))
+}
+
+func (t *SubsetTest) SupersetNeedsReassignment() {
+	// A greedy assignment would pair "taco" with the first matcher and then
+	// fail to find a partner for the second.
+	m := IsSupersetOf(HasSubstr("a"), "taco")
+
+	err := m.Matches([]string{"taco", "salsa"})
+	ExpectEq(nil, err)
+}
+
+func (t *SubsetTest) SubsetMatches() {
+	m := IsSubsetOf("taco", HasSubstr("rit"), "queso")
+
+	var c interface{}
+	var err error
+
+	// Empty
+	c = []string{}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+
+	// Exact set, out of order
+	c = []string{"queso", "burrito", "taco"}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+
+	// Partial
+	c = [...]string{"burrito"}
+	err = m.Matches(c)
+	ExpectEq(nil, err)
+}
+
+func (t *SubsetTest) SubsetDoesntMatch() {
+	m := IsSubsetOf("taco", HasSubstr("rit"))
+
+	var c interface{}
+	var err error
+
+	// Unexpected elements
+	c = []string{"enchilada", "taco", "queso"}
+	err = m.Matches(c)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has unexpected elements: [\"enchilada\", \"queso\"]")))
+
+	// Mixed types
+	c = []interface{}{"taco", 17}
+	err = m.Matches(c)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has unexpected elements: [17]")))
+}
+
+func (t *SubsetTest) SubsetMultiplicity() {
+	m := IsSubsetOf("taco", "taco", "burrito")
+
+	ExpectEq(nil, m.Matches([]string{"taco", "taco"}))
+	ExpectThat(
+		m.Matches([]string{"taco", "taco", "taco"}),
+		Error(Equals("which has unexpected elements: [\"taco\"]")))
+}
+
+func (t *SubsetTest) SubsetNeedsReassignment() {
+	// A greedy assignment would pair "taco" with the first matcher and then
+	// fail to find a partner for "tortilla".
+	m := IsSubsetOf(HasSubstr("t"), "taco")
+
+	err := m.Matches([]string{"taco", "tortilla"})
+	ExpectEq(nil, err)
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// The matchers in this file, like HasSameTypeAs, examine the dynamic type of
+// the candidate. A nil interface candidate has no type, and matches none of
+// them.
+
+// Implements returns a matcher that matches values whose type implements the
+// interface type that i points to. i is typically a nil pointer to the
+// interface type, and may also be the reflect.Type for it:
+//
+//     Implements((*io.Reader)(nil))
+//
+// Implements will panic if i doesn't identify an interface type.
+func Implements(i interface{}) Matcher {
+	var iface reflect.Type
+	if t, ok := i.(reflect.Type); ok {
+		iface = t
+	} else if t := reflect.TypeOf(i); t != nil && t.Kind() == reflect.Ptr {
+		iface = t.Elem()
+	}
+
+	if iface == nil || iface.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("Implements: %v is not a pointer to an interface type", reflect.TypeOf(i)))
+	}
+
+	return newTypeMatcher(
+		"Implements",
+		fmt.Sprintf("implementing %v", iface),
+		func(t reflect.Type) bool { return t.Implements(iface) })
+}
+
+// AssignableTo returns a matcher that matches values assignable to the type
+// of the supplied prototype. The prototype may also be a reflect.Type, which
+// is useful for interface types.
+func AssignableTo(p interface{}) Matcher {
+	target := prototypeType("AssignableTo", p)
+	return newTypeMatcher(
+		"AssignableTo",
+		fmt.Sprintf("assignable to %v", target),
+		func(t reflect.Type) bool { return t.AssignableTo(target) })
+}
+
+// ConvertibleTo returns a matcher that matches values convertible to the type
+// of the supplied prototype, in the sense of reflect.Type.ConvertibleTo. The
+// prototype may also be a reflect.Type.
+func ConvertibleTo(p interface{}) Matcher {
+	target := prototypeType("ConvertibleTo", p)
+	return newTypeMatcher(
+		"ConvertibleTo",
+		fmt.Sprintf("convertible to %v", target),
+		func(t reflect.Type) bool { return t.ConvertibleTo(target) })
+}
+
+// KindIs returns a matcher that matches values whose type has the supplied
+// kind.
+func KindIs(k reflect.Kind) Matcher {
+	pred := func(c interface{}) error {
+		t := reflect.TypeOf(c)
+		if t == nil || t.Kind() != k {
+			return errors.New(describeCandidateKind(t))
+		}
+
+		return nil
+	}
+
+	return newMatcherWithNegation(
+		"KindIs",
+		pred,
+		fmt.Sprintf("has type of kind %v", k),
+		fmt.Sprintf("doesn't have type of kind %v", k))
+}
+
+// TypeMatches returns a matcher that matches values whose type, as a string
+// formatted with %v (e.g. "*bytes.Buffer" or "map[string]int"), matches m.
+// The type of a nil interface value is "<nil>". If m is not a Matcher, it is
+// treated as Equals(m).
+func TypeMatches(m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	pred := func(c interface{}) error {
+		t := fmt.Sprintf("%v", reflect.TypeOf(c))
+		err := wrapped.Matches(t)
+		if err == nil {
+			return nil
+		}
+
+		s := fmt.Sprintf("which has type %s", t)
+		if err.Error() != "" {
+			s += ", " + err.Error()
+		}
+
+		if _, isFatal := err.(*FatalError); isFatal {
+			return NewFatalError(s)
+		}
+
+		return errors.New(s)
+	}
+
+	return newMatcherWithNegation(
+		"TypeMatches",
+		pred,
+		fmt.Sprintf("has type matching: %s", wrapped.Description()),
+		fmt.Sprintf("doesn't have type matching: %s", wrapped.Description()))
+}
+
+// Return the type of a prototype, which may itself be a reflect.Type.
+func prototypeType(name string, p interface{}) reflect.Type {
+	if t, ok := p.(reflect.Type); ok {
+		return t
+	}
+
+	t := reflect.TypeOf(p)
+	if t == nil {
+		panic(name + ": nil prototype has no type; use a reflect.Type instead")
+	}
+
+	return t
+}
+
+// Return a matcher for the types satisfying the supplied predicate, described
+// by the supplied phrase. The name is that of the function creating it.
+func newTypeMatcher(name string, phrase string, f func(reflect.Type) bool) Matcher {
+	pred := func(c interface{}) error {
+		t := reflect.TypeOf(c)
+		if t == nil || !f(t) {
+			return fmt.Errorf("which has type %v", t)
+		}
+
+		return nil
+	}
+
+	return newMatcherWithNegation(
+		name,
+		pred,
+		fmt.Sprintf("has type %s", phrase),
+		fmt.Sprintf("doesn't have type %s", phrase))
+}
+
+func describeCandidateKind(t reflect.Type) string {
+	if t == nil {
+		return "which has type <nil>"
+	}
+
+	return fmt.Sprintf("which has type %v of kind %v", t, t.Kind())
+}
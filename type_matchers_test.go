@@ -0,0 +1,136 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type TypeMatchersTest struct {
+}
+
+func init() { RegisterTestSuite(&TypeMatchersTest{}) }
+
+type typeMatchersCelsius float64
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *TypeMatchersTest) Descriptions() {
+	ExpectEq("has type implementing io.Reader", Implements((*io.Reader)(nil)).Description())
+	ExpectEq(
+		"doesn't have type implementing io.Reader",
+		Not(Implements((*io.Reader)(nil))).Description())
+
+	ExpectEq("has type assignable to int", AssignableTo(17).Description())
+	ExpectEq(
+		"has type assignable to fmt.Stringer",
+		AssignableTo(reflect.TypeOf((*fmt.Stringer)(nil)).Elem()).Description())
+
+	ExpectEq("has type convertible to float64", ConvertibleTo(0.0).Description())
+	ExpectEq("has type of kind slice", KindIs(reflect.Slice).Description())
+	ExpectEq("doesn't have type of kind slice", Not(KindIs(reflect.Slice)).Description())
+
+	ExpectEq(
+		"has type matching: has substring \"bytes\"",
+		TypeMatches(HasSubstr("bytes")).Description())
+}
+
+func (t *TypeMatchersTest) ImplementsPanicsForNonInterfaces() {
+	ExpectThat(func() { Implements(17) }, Panics(HasSubstr("int is not a pointer to an interface")))
+	ExpectThat(func() { Implements(nil) }, Panics(HasSubstr("<nil> is not a pointer to an interface")))
+	ExpectThat(func() { Implements(new(int)) }, Panics(HasSubstr("*int is not a pointer to an interface")))
+}
+
+func (t *TypeMatchersTest) Implements() {
+	m := Implements((*io.Reader)(nil))
+
+	ExpectEq(nil, m.Matches(new(bytes.Buffer)))
+	ExpectEq(nil, m.Matches(strings.NewReader("")))
+
+	err := m.Matches(bytes.Buffer{})
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has type bytes.Buffer")))
+
+	err = m.Matches(nil)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has type <nil>")))
+
+	// reflect.Type form
+	m = Implements(reflect.TypeOf((*fmt.Stringer)(nil)).Elem())
+	ExpectEq(nil, m.Matches(new(bytes.Buffer)))
+	ExpectNe(nil, m.Matches(17))
+}
+
+func (t *TypeMatchersTest) AssignableTo() {
+	ExpectEq(nil, AssignableTo(17).Matches(19))
+	ExpectThat(AssignableTo(17).Matches(int64(19)), Error(Equals("which has type int64")))
+
+	m := AssignableTo(reflect.TypeOf((*io.Reader)(nil)).Elem())
+	ExpectEq(nil, m.Matches(new(bytes.Buffer)))
+	ExpectThat(m.Matches("taco"), Error(Equals("which has type string")))
+
+	ExpectThat(func() { AssignableTo(nil) }, Panics(HasSubstr("nil prototype")))
+}
+
+func (t *TypeMatchersTest) ConvertibleTo() {
+	m := ConvertibleTo(0.0)
+
+	ExpectEq(nil, m.Matches(17))
+	ExpectEq(nil, m.Matches(typeMatchersCelsius(17)))
+	ExpectThat(m.Matches("taco"), Error(Equals("which has type string")))
+	ExpectThat(m.Matches(nil), Error(Equals("which has type <nil>")))
+}
+
+func (t *TypeMatchersTest) KindIs() {
+	ExpectEq(nil, KindIs(reflect.Float64).Matches(typeMatchersCelsius(17)))
+	ExpectEq(nil, KindIs(reflect.Slice).Matches([]int{}))
+	ExpectEq(nil, KindIs(reflect.Ptr).Matches((*int)(nil)))
+
+	err := KindIs(reflect.Int).Matches(typeMatchersCelsius(17))
+	ExpectFalse(isFatal(err))
+	ExpectThat(
+		err,
+		Error(Equals("which has type oglematchers_test.typeMatchersCelsius of kind float64")))
+
+	ExpectThat(KindIs(reflect.Interface).Matches(nil), Error(Equals("which has type <nil>")))
+}
+
+func (t *TypeMatchersTest) TypeMatches() {
+	ExpectEq(nil, TypeMatches("*bytes.Buffer").Matches(new(bytes.Buffer)))
+	ExpectEq(nil, TypeMatches(HasSubstr("map[")).Matches(map[string]int{}))
+	ExpectEq(nil, TypeMatches("<nil>").Matches(nil))
+
+	err := TypeMatches(MatchesRegexp(`^\*`)).Matches(17)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has type int")))
+
+	err = TypeMatches(LessThan(17)).Matches(17)
+	ExpectTrue(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("which has type int, ")))
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// WhenDynamicTypeIs returns a matcher that matches values whose dynamic type
+// is the type of the supplied prototype, and which then match m. This is
+// useful for mock arguments of interface type, which must be asserted to a
+// concrete type before they can be examined:
+//
+//     WhenDynamicTypeIs(&circle{}, Property("Radius", GreaterThan(2)))
+//
+// If the candidate doesn't have the required type but is a non-nil pointer,
+// its pointee is tried in turn, and so on; a pointer to an interface is
+// followed to the interface's dynamic value. This allows e.g. a *Shape
+// holding a circle, or a *circle, to be matched by
+// WhenDynamicTypeIs(circle{}, ...).
+//
+// The prototype may also be a reflect.Type. If that type is an interface, the
+// first value implementing it is given to m. If m is not a Matcher, it is
+// treated as Equals(m).
+func WhenDynamicTypeIs(p interface{}, m interface{}) Matcher {
+	wrapped, ok := m.(Matcher)
+	if !ok {
+		wrapped = Equals(m)
+	}
+
+	return &dynamicTypeMatcher{prototypeType("WhenDynamicTypeIs", p), wrapped}
+}
+
+type dynamicTypeMatcher struct {
+	target  reflect.Type
+	wrapped Matcher
+}
+
+func (m *dynamicTypeMatcher) Description() string {
+	return fmt.Sprintf("has dynamic type %v, and %s", m.target, m.wrapped.Description())
+}
+
+func (m *dynamicTypeMatcher) DescribeNegation() string {
+	return fmt.Sprintf(
+		"doesn't have dynamic type %v, or %s",
+		m.target,
+		describeNegation(m.wrapped))
+}
+
+func (m *dynamicTypeMatcher) accepts(t reflect.Type) bool {
+	if m.target.Kind() == reflect.Interface {
+		return t.Implements(m.target)
+	}
+
+	return t == m.target
+}
+
+func (m *dynamicTypeMatcher) Matches(c interface{}) error {
+	return observeMatch(nil, m, c)
+}
+
+func (m *dynamicTypeMatcher) observedMatches(
+	o *observation,
+	c interface{}) (err error) {
+	// Follow pointers until we find a value of the target type. Keep track of
+	// the most recently seen dynamic type, for use in error messages.
+	v := reflect.ValueOf(c)
+	dynamic := reflect.TypeOf(c)
+
+	for !v.IsValid() || !m.accepts(v.Type()) {
+		if !v.IsValid() || v.Kind() != reflect.Ptr || v.IsNil() {
+			return errors.New(fmt.Sprintf("which has dynamic type %v", dynamic))
+		}
+
+		v = v.Elem()
+		if v.Kind() == reflect.Interface {
+			v = v.Elem()
+			dynamic = nil
+			if v.IsValid() {
+				dynamic = v.Type()
+			}
+		}
+	}
+
+	// Defer to the wrapped matcher, fixing up empty errors as Pointee does.
+	value := v.Interface()
+	err = o.matches(m.wrapped, value)
+	if err != nil && err.Error() == "" {
+		s := fmt.Sprintf("whose dynamic value is %s", FormatValue(value))
+
+		if _, ok := err.(*FatalError); ok {
+			err = NewFatalError(s)
+		} else {
+			err = errors.New(s)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Aaron Jacobs. All Rights Reserved.
+// Author: aaronjjacobs@gmail.com (Aaron Jacobs)
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oglematchers_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	. "github.com/jacobsa/oglematchers"
+	. "github.com/jacobsa/ogletest"
+)
+
+////////////////////////////////////////////////////////////////////////
+// Helpers
+////////////////////////////////////////////////////////////////////////
+
+type WhenDynamicTypeIsTest struct {
+}
+
+func init() { RegisterTestSuite(&WhenDynamicTypeIsTest{}) }
+
+type dynamicTypeShape interface {
+	Area() float64
+}
+
+type dynamicTypeCircle struct {
+	R float64
+}
+
+func (c dynamicTypeCircle) Area() float64 { return 3 * c.R * c.R }
+
+type dynamicTypeSquare struct {
+	S float64
+}
+
+func (s *dynamicTypeSquare) Area() float64 { return s.S * s.S }
+
+////////////////////////////////////////////////////////////////////////
+// Tests
+////////////////////////////////////////////////////////////////////////
+
+func (t *WhenDynamicTypeIsTest) Description() {
+	m := WhenDynamicTypeIs(dynamicTypeCircle{}, Property("Area", GreaterThan(2)))
+	ExpectEq(
+		"has dynamic type oglematchers_test.dynamicTypeCircle, and "+
+			"whose Area() matches: greater than 2",
+		m.Description())
+
+	ExpectEq(
+		"doesn't have dynamic type oglematchers_test.dynamicTypeCircle, or "+
+			"whose Area() doesn't match: greater than 2",
+		Not(m).Description())
+}
+
+func (t *WhenDynamicTypeIsTest) ExactType() {
+	var shape dynamicTypeShape = dynamicTypeCircle{2}
+	m := WhenDynamicTypeIs(dynamicTypeCircle{}, DeepEquals(dynamicTypeCircle{2}))
+
+	ExpectEq(nil, m.Matches(shape))
+
+	err := m.Matches(dynamicTypeCircle{3})
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("whose dynamic value is {R:3}")))
+}
+
+func (t *WhenDynamicTypeIsTest) WrongType() {
+	m := WhenDynamicTypeIs(dynamicTypeCircle{}, Any())
+
+	err := m.Matches(&dynamicTypeSquare{1})
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has dynamic type *oglematchers_test.dynamicTypeSquare")))
+
+	err = m.Matches(nil)
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(Equals("which has dynamic type <nil>")))
+
+	err = m.Matches(17)
+	ExpectThat(err, Error(Equals("which has dynamic type int")))
+}
+
+func (t *WhenDynamicTypeIsTest) ThroughPointers() {
+	m := WhenDynamicTypeIs(dynamicTypeCircle{}, Property("Area", 12.0))
+
+	c := dynamicTypeCircle{2}
+	pc := &c
+	ExpectEq(nil, m.Matches(pc))
+	ExpectEq(nil, m.Matches(&pc))
+
+	var shape dynamicTypeShape = c
+	ExpectEq(nil, m.Matches(&shape))
+
+	// The error describes the dynamic type of the interface, not the pointer.
+	shape = &dynamicTypeSquare{1}
+	ExpectThat(
+		m.Matches(&shape),
+		Error(Equals("which has dynamic type *oglematchers_test.dynamicTypeSquare")))
+
+	shape = nil
+	ExpectThat(m.Matches(&shape), Error(Equals("which has dynamic type <nil>")))
+
+	ExpectThat(
+		m.Matches((*dynamicTypeCircle)(nil)),
+		Error(Equals("which has dynamic type *oglematchers_test.dynamicTypeCircle")))
+}
+
+func (t *WhenDynamicTypeIsTest) PointerTarget() {
+	m := WhenDynamicTypeIs(&dynamicTypeSquare{}, Property("Area", 4.0))
+
+	var shape dynamicTypeShape = &dynamicTypeSquare{2}
+	ExpectEq(nil, m.Matches(shape))
+	ExpectEq(nil, m.Matches(&shape))
+
+	err := m.Matches(dynamicTypeSquare{2})
+	ExpectThat(err, Error(Equals("which has dynamic type oglematchers_test.dynamicTypeSquare")))
+}
+
+func (t *WhenDynamicTypeIsTest) InterfaceTarget() {
+	stringer := reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	m := WhenDynamicTypeIs(stringer, Property("String", "taco"))
+
+	ExpectEq(nil, m.Matches(bytes.NewBufferString("taco")))
+	ExpectThat(m.Matches("taco"), Error(Equals("which has dynamic type string")))
+}
+
+func (t *WhenDynamicTypeIsTest) WrappedErrors() {
+	m := WhenDynamicTypeIs(dynamicTypeCircle{}, Property("Area", LessThan(5)))
+
+	err := m.Matches(dynamicTypeCircle{2})
+	ExpectFalse(isFatal(err))
+	ExpectThat(err, Error(HasSubstr("12")))
+
+	m = WhenDynamicTypeIs(dynamicTypeCircle{}, HasSubstr("taco"))
+	err = m.Matches(dynamicTypeCircle{2})
+	ExpectTrue(isFatal(err))
+
+	// Non-matchers are treated as Equals.
+	ExpectEq(nil, WhenDynamicTypeIs(0, 17).Matches(17))
+	ExpectNe(nil, WhenDynamicTypeIs(0, 17).Matches(19))
+}
+
+func (t *WhenDynamicTypeIsTest) NilPrototype() {
+	ExpectThat(func() { WhenDynamicTypeIs(nil, Any()) }, Panics(HasSubstr("nil prototype")))
+}